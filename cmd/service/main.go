@@ -1,12 +1,16 @@
 package main
 
 import (
+	"content/config"
 	"content/genproto/content"
 	"content/genproto/itineraries"
 	"content/genproto/story"
 
 	"content/service"
+	"content/storage/blob"
 	"content/storage/postgres"
+	"content/storage/redis"
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -20,6 +24,12 @@ func main() {
 		panic(err)
 	}
 	defer db.Close()
+
+	cfg := config.Load()
+
+	rdb := redis.ConnectDB()
+	defer rdb.Close()
+
 	fmt.Println("Starting server...")
 	lis, err := net.Listen("tcp", ":50052")
 	if err != nil {
@@ -27,9 +37,28 @@ func main() {
 	}
 	defer lis.Close()
 
-	Servicecn := service.NewContentService(db)
-	Servicest := service.NewStoryService(db)
-	Serviceit := service.NewItinerariesService(db)
+	blobs, err := blob.NewLocalStore(cfg.Media.MEDIA_DIR, cfg.Media.MEDIA_BASE_URL)
+	if err != nil {
+		log.Fatalf("error while opening media store: %v", err)
+	}
+
+	Servicest := service.NewStoryService(db, rdb)
+	Servicecn := service.NewContentService(db, rdb, blobs, Servicest)
+	Serviceit := service.NewItinerariesService(db, rdb)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		if err := Servicecn.ConsumeUserDeleted(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("user deleted consumer stopped: %v", err)
+		}
+	}()
+	go Servicest.RunPublishScheduler(ctx, cfg.Jobs.PUBLISH_INTERVAL)
+	go Servicecn.RunMediaPipeline(ctx, cfg.Jobs.MEDIA_PIPELINE_INTERVAL)
+	go Servicest.RunTrendingRecompute(ctx, cfg.Jobs.TRENDING_INTERVAL)
+	go Servicest.RunViewsFlush(ctx, cfg.Jobs.VIEWS_FLUSH_INTERVAL)
+	go Servicest.RunReadingStatsBackfill(ctx, cfg.Jobs.READING_STATS_INTERVAL)
 
 	server := grpc.NewServer()
 
@@ -3,14 +3,24 @@ package config
 import (
 	"log"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
 )
 
 type Config struct {
-	Postgres PostgresConfig
-	Server   ServerConfig
+	Postgres   PostgresConfig
+	Server     ServerConfig
+	Redis      RedisConfig
+	Erasure    ErasureConfig
+	Jobs       JobsConfig
+	Media      MediaConfig
+	Feed       FeedConfig
+	Views      ViewsConfig
+	Moderation ModerationConfig
+	Related    RelatedConfig
 }
 
 type PostgresConfig struct {
@@ -25,6 +35,55 @@ type ServerConfig struct {
 	USER_PORT string
 }
 
+type RedisConfig struct {
+	REDIS_ADDR     string
+	REDIS_PASSWORD string
+	REDIS_DB       int
+}
+
+type JobsConfig struct {
+	PUBLISH_INTERVAL        time.Duration
+	MEDIA_PIPELINE_INTERVAL time.Duration
+	TRENDING_INTERVAL       time.Duration
+	VIEWS_FLUSH_INTERVAL    time.Duration
+	READING_STATS_INTERVAL  time.Duration
+}
+
+type MediaConfig struct {
+	MEDIA_DIR      string
+	MEDIA_BASE_URL string
+	MEDIA_MAX_SIZE int64
+}
+
+type FeedConfig struct {
+	FEED_HEAVY_READER_THRESHOLD int64
+	FEED_TIMELINE_SIZE          int64
+	FEED_TIMELINE_TTL           time.Duration
+}
+
+type ViewsConfig struct {
+	VIEW_WINDOW time.Duration
+}
+
+type RelatedConfig struct {
+	RELATED_CACHE_TTL time.Duration
+}
+
+type ModerationConfig struct {
+	MODERATION_AUTO_HIDE_THRESHOLD int64
+	MODERATION_BLOCKED_WORDS       []string
+	MODERATION_FLAGGED_WORDS       []string
+	MODERATION_MAX_LINKS           int
+	MODERATION_DUPLICATE_WINDOW    time.Duration
+	MODERATION_HOLD_SCORE          float64
+	MODERATION_REJECT_SCORE        float64
+}
+
+type ErasureConfig struct {
+	ERASURE_POLICY       string
+	USER_DELETED_CHANNEL string
+}
+
 func Load() *Config {
 	if err := godotenv.Load(".env"); err != nil {
 		log.Printf("error while loading .env file: %v", err)
@@ -41,6 +100,47 @@ func Load() *Config {
 		Server: ServerConfig{
 			USER_PORT: cast.ToString(coalesce("USER_PORT", ":50051")),
 		},
+		Redis: RedisConfig{
+			REDIS_ADDR:     cast.ToString(coalesce("REDIS_ADDR", "localhost:6379")),
+			REDIS_PASSWORD: cast.ToString(coalesce("REDIS_PASSWORD", "")),
+			REDIS_DB:       cast.ToInt(coalesce("REDIS_DB", 0)),
+		},
+		Erasure: ErasureConfig{
+			ERASURE_POLICY:       cast.ToString(coalesce("ERASURE_POLICY", "delete")),
+			USER_DELETED_CHANNEL: cast.ToString(coalesce("USER_DELETED_CHANNEL", "users.deleted")),
+		},
+		Jobs: JobsConfig{
+			PUBLISH_INTERVAL:        cast.ToDuration(coalesce("PUBLISH_INTERVAL", "30s")),
+			MEDIA_PIPELINE_INTERVAL: cast.ToDuration(coalesce("MEDIA_PIPELINE_INTERVAL", "5s")),
+			TRENDING_INTERVAL:       cast.ToDuration(coalesce("TRENDING_INTERVAL", "10m")),
+			VIEWS_FLUSH_INTERVAL:    cast.ToDuration(coalesce("VIEWS_FLUSH_INTERVAL", "1m")),
+			READING_STATS_INTERVAL:  cast.ToDuration(coalesce("READING_STATS_INTERVAL", "10m")),
+		},
+		Media: MediaConfig{
+			MEDIA_DIR:      cast.ToString(coalesce("MEDIA_DIR", "./media")),
+			MEDIA_BASE_URL: cast.ToString(coalesce("MEDIA_BASE_URL", "http://localhost:8080/media")),
+			MEDIA_MAX_SIZE: cast.ToInt64(coalesce("MEDIA_MAX_SIZE", 10<<20)),
+		},
+		Feed: FeedConfig{
+			FEED_HEAVY_READER_THRESHOLD: cast.ToInt64(coalesce("FEED_HEAVY_READER_THRESHOLD", 20)),
+			FEED_TIMELINE_SIZE:          cast.ToInt64(coalesce("FEED_TIMELINE_SIZE", 500)),
+			FEED_TIMELINE_TTL:           cast.ToDuration(coalesce("FEED_TIMELINE_TTL", "72h")),
+		},
+		Views: ViewsConfig{
+			VIEW_WINDOW: cast.ToDuration(coalesce("VIEW_WINDOW", "24h")),
+		},
+		Moderation: ModerationConfig{
+			MODERATION_AUTO_HIDE_THRESHOLD: cast.ToInt64(coalesce("MODERATION_AUTO_HIDE_THRESHOLD", 3)),
+			MODERATION_BLOCKED_WORDS:       splitList(cast.ToString(coalesce("MODERATION_BLOCKED_WORDS", ""))),
+			MODERATION_FLAGGED_WORDS:       splitList(cast.ToString(coalesce("MODERATION_FLAGGED_WORDS", ""))),
+			MODERATION_MAX_LINKS:           cast.ToInt(coalesce("MODERATION_MAX_LINKS", 2)),
+			MODERATION_DUPLICATE_WINDOW:    cast.ToDuration(coalesce("MODERATION_DUPLICATE_WINDOW", "1h")),
+			MODERATION_HOLD_SCORE:          cast.ToFloat64(coalesce("MODERATION_HOLD_SCORE", 1)),
+			MODERATION_REJECT_SCORE:        cast.ToFloat64(coalesce("MODERATION_REJECT_SCORE", 3)),
+		},
+		Related: RelatedConfig{
+			RELATED_CACHE_TTL: cast.ToDuration(coalesce("RELATED_CACHE_TTL", "1h")),
+		},
 	}
 }
 
@@ -51,3 +151,14 @@ func coalesce(key string, value interface{}) interface{} {
 	}
 	return value
 }
+
+// splitList parses a comma separated list, dropping empty entries.
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -30,6 +30,17 @@ type ContentClient interface {
 	GetTips(ctx context.Context, in *GetTipsReq, opts ...grpc.CallOption) (*GetTipsRes, error)
 	GetUserStat(ctx context.Context, in *GetUserStatReq, opts ...grpc.CallOption) (*GetUserStatRes, error)
 	TopDestinations(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Answer, error)
+	EraseUserContent(ctx context.Context, in *EraseUserContentReq, opts ...grpc.CallOption) (*EraseUserContentRes, error)
+	UploadMedia(ctx context.Context, opts ...grpc.CallOption) (Content_UploadMediaClient, error)
+	FindNearby(ctx context.Context, in *FindNearbyReq, opts ...grpc.CallOption) (*FindNearbyRes, error)
+	GetFeed(ctx context.Context, in *GetFeedReq, opts ...grpc.CallOption) (*GetFeedRes, error)
+	AddBookmark(ctx context.Context, in *BookmarkReq, opts ...grpc.CallOption) (*Bookmark, error)
+	RemoveBookmark(ctx context.Context, in *BookmarkReq, opts ...grpc.CallOption) (*Void, error)
+	ListBookmarks(ctx context.Context, in *ListBookmarksReq, opts ...grpc.CallOption) (*ListBookmarksRes, error)
+	ListMentions(ctx context.Context, in *ListMentionsReq, opts ...grpc.CallOption) (*ListMentionsRes, error)
+	ReportContent(ctx context.Context, in *ReportContentReq, opts ...grpc.CallOption) (*Report, error)
+	ListReports(ctx context.Context, in *ListReportsReq, opts ...grpc.CallOption) (*ListReportsRes, error)
+	ResolveReport(ctx context.Context, in *ResolveReportReq, opts ...grpc.CallOption) (*ResolveReportRes, error)
 }
 
 type contentClient struct {
@@ -112,6 +123,130 @@ func (c *contentClient) TopDestinations(ctx context.Context, in *Void, opts ...g
 	return out, nil
 }
 
+func (c *contentClient) EraseUserContent(ctx context.Context, in *EraseUserContentReq, opts ...grpc.CallOption) (*EraseUserContentRes, error) {
+	out := new(EraseUserContentRes)
+	err := c.cc.Invoke(ctx, "/content.Content/EraseUserContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) UploadMedia(ctx context.Context, opts ...grpc.CallOption) (Content_UploadMediaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Content_ServiceDesc.Streams[0], "/content.Content/UploadMedia", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contentUploadMediaClient{stream}
+	return x, nil
+}
+
+type Content_UploadMediaClient interface {
+	Send(*UploadMediaReq) error
+	CloseAndRecv() (*Media, error)
+	grpc.ClientStream
+}
+
+type contentUploadMediaClient struct {
+	grpc.ClientStream
+}
+
+func (x *contentUploadMediaClient) Send(m *UploadMediaReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *contentUploadMediaClient) CloseAndRecv() (*Media, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Media)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contentClient) FindNearby(ctx context.Context, in *FindNearbyReq, opts ...grpc.CallOption) (*FindNearbyRes, error) {
+	out := new(FindNearbyRes)
+	err := c.cc.Invoke(ctx, "/content.Content/FindNearby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) GetFeed(ctx context.Context, in *GetFeedReq, opts ...grpc.CallOption) (*GetFeedRes, error) {
+	out := new(GetFeedRes)
+	err := c.cc.Invoke(ctx, "/content.Content/GetFeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) AddBookmark(ctx context.Context, in *BookmarkReq, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/content.Content/AddBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) RemoveBookmark(ctx context.Context, in *BookmarkReq, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/content.Content/RemoveBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ListBookmarks(ctx context.Context, in *ListBookmarksReq, opts ...grpc.CallOption) (*ListBookmarksRes, error) {
+	out := new(ListBookmarksRes)
+	err := c.cc.Invoke(ctx, "/content.Content/ListBookmarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ListMentions(ctx context.Context, in *ListMentionsReq, opts ...grpc.CallOption) (*ListMentionsRes, error) {
+	out := new(ListMentionsRes)
+	err := c.cc.Invoke(ctx, "/content.Content/ListMentions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ReportContent(ctx context.Context, in *ReportContentReq, opts ...grpc.CallOption) (*Report, error) {
+	out := new(Report)
+	err := c.cc.Invoke(ctx, "/content.Content/ReportContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ListReports(ctx context.Context, in *ListReportsReq, opts ...grpc.CallOption) (*ListReportsRes, error) {
+	out := new(ListReportsRes)
+	err := c.cc.Invoke(ctx, "/content.Content/ListReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ResolveReport(ctx context.Context, in *ResolveReportReq, opts ...grpc.CallOption) (*ResolveReportRes, error) {
+	out := new(ResolveReportRes)
+	err := c.cc.Invoke(ctx, "/content.Content/ResolveReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContentServer is the server API for Content service.
 // All implementations must embed UnimplementedContentServer
 // for forward compatibility
@@ -124,6 +259,17 @@ type ContentServer interface {
 	GetTips(context.Context, *GetTipsReq) (*GetTipsRes, error)
 	GetUserStat(context.Context, *GetUserStatReq) (*GetUserStatRes, error)
 	TopDestinations(context.Context, *Void) (*Answer, error)
+	EraseUserContent(context.Context, *EraseUserContentReq) (*EraseUserContentRes, error)
+	UploadMedia(Content_UploadMediaServer) error
+	FindNearby(context.Context, *FindNearbyReq) (*FindNearbyRes, error)
+	GetFeed(context.Context, *GetFeedReq) (*GetFeedRes, error)
+	AddBookmark(context.Context, *BookmarkReq) (*Bookmark, error)
+	RemoveBookmark(context.Context, *BookmarkReq) (*Void, error)
+	ListBookmarks(context.Context, *ListBookmarksReq) (*ListBookmarksRes, error)
+	ListMentions(context.Context, *ListMentionsReq) (*ListMentionsRes, error)
+	ReportContent(context.Context, *ReportContentReq) (*Report, error)
+	ListReports(context.Context, *ListReportsReq) (*ListReportsRes, error)
+	ResolveReport(context.Context, *ResolveReportReq) (*ResolveReportRes, error)
 	mustEmbedUnimplementedContentServer()
 }
 
@@ -155,6 +301,39 @@ func (UnimplementedContentServer) GetUserStat(context.Context, *GetUserStatReq)
 func (UnimplementedContentServer) TopDestinations(context.Context, *Void) (*Answer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TopDestinations not implemented")
 }
+func (UnimplementedContentServer) EraseUserContent(context.Context, *EraseUserContentReq) (*EraseUserContentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUserContent not implemented")
+}
+func (UnimplementedContentServer) UploadMedia(Content_UploadMediaServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (UnimplementedContentServer) FindNearby(context.Context, *FindNearbyReq) (*FindNearbyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearby not implemented")
+}
+func (UnimplementedContentServer) GetFeed(context.Context, *GetFeedReq) (*GetFeedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedContentServer) AddBookmark(context.Context, *BookmarkReq) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookmark not implemented")
+}
+func (UnimplementedContentServer) RemoveBookmark(context.Context, *BookmarkReq) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
+}
+func (UnimplementedContentServer) ListBookmarks(context.Context, *ListBookmarksReq) (*ListBookmarksRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (UnimplementedContentServer) ListMentions(context.Context, *ListMentionsReq) (*ListMentionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMentions not implemented")
+}
+func (UnimplementedContentServer) ReportContent(context.Context, *ReportContentReq) (*Report, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportContent not implemented")
+}
+func (UnimplementedContentServer) ListReports(context.Context, *ListReportsReq) (*ListReportsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedContentServer) ResolveReport(context.Context, *ResolveReportReq) (*ResolveReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
 func (UnimplementedContentServer) mustEmbedUnimplementedContentServer() {}
 
 // UnsafeContentServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +491,212 @@ func _Content_TopDestinations_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Content_EraseUserContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).EraseUserContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/EraseUserContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).EraseUserContent(ctx, req.(*EraseUserContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_UploadMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContentServer).UploadMedia(&contentUploadMediaServer{stream})
+}
+
+type Content_UploadMediaServer interface {
+	SendAndClose(*Media) error
+	Recv() (*UploadMediaReq, error)
+	grpc.ServerStream
+}
+
+type contentUploadMediaServer struct {
+	grpc.ServerStream
+}
+
+func (x *contentUploadMediaServer) SendAndClose(m *Media) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *contentUploadMediaServer) Recv() (*UploadMediaReq, error) {
+	m := new(UploadMediaReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Content_FindNearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearbyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).FindNearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/FindNearby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).FindNearby(ctx, req.(*FindNearbyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/GetFeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).GetFeed(ctx, req.(*GetFeedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_AddBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookmarkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).AddBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/AddBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).AddBookmark(ctx, req.(*BookmarkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookmarkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).RemoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/RemoveBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).RemoveBookmark(ctx, req.(*BookmarkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ListBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ListBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/ListBookmarks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ListBookmarks(ctx, req.(*ListBookmarksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ListMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMentionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ListMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/ListMentions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ListMentions(ctx, req.(*ListMentionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ReportContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ReportContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/ReportContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ReportContent(ctx, req.(*ReportContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/ListReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ListReports(ctx, req.(*ListReportsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/content.Content/ResolveReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ResolveReport(ctx, req.(*ResolveReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Content_ServiceDesc is the grpc.ServiceDesc for Content service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,7 +736,53 @@ var Content_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TopDestinations",
 			Handler:    _Content_TopDestinations_Handler,
 		},
+		{
+			MethodName: "EraseUserContent",
+			Handler:    _Content_EraseUserContent_Handler,
+		},
+		{
+			MethodName: "FindNearby",
+			Handler:    _Content_FindNearby_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _Content_GetFeed_Handler,
+		},
+		{
+			MethodName: "AddBookmark",
+			Handler:    _Content_AddBookmark_Handler,
+		},
+		{
+			MethodName: "RemoveBookmark",
+			Handler:    _Content_RemoveBookmark_Handler,
+		},
+		{
+			MethodName: "ListBookmarks",
+			Handler:    _Content_ListBookmarks_Handler,
+		},
+		{
+			MethodName: "ListMentions",
+			Handler:    _Content_ListMentions_Handler,
+		},
+		{
+			MethodName: "ReportContent",
+			Handler:    _Content_ReportContent_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _Content_ListReports_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _Content_ResolveReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadMedia",
+			Handler:       _Content_UploadMedia_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "content.proto",
 }
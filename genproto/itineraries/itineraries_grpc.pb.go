@@ -28,6 +28,9 @@ type ItinerariesClient interface {
 	GetItineraries(ctx context.Context, in *GetItinerariesReq, opts ...grpc.CallOption) (*GetItinerariesRes, error)
 	GetItinerariesById(ctx context.Context, in *StoryId, opts ...grpc.CallOption) (*GetItinerariesByIdRes, error)
 	CommentItineraries(ctx context.Context, in *CommentItinerariesReq, opts ...grpc.CallOption) (*CommentItinerariesRes, error)
+	EditComment(ctx context.Context, in *EditCommentReq, opts ...grpc.CallOption) (*CommentItinerariesRes, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*Void, error)
+	SetItineraryMedia(ctx context.Context, in *SetItineraryMediaReq, opts ...grpc.CallOption) (*ItineraryMediaRes, error)
 }
 
 type itinerariesClient struct {
@@ -92,6 +95,33 @@ func (c *itinerariesClient) CommentItineraries(ctx context.Context, in *CommentI
 	return out, nil
 }
 
+func (c *itinerariesClient) EditComment(ctx context.Context, in *EditCommentReq, opts ...grpc.CallOption) (*CommentItinerariesRes, error) {
+	out := new(CommentItinerariesRes)
+	err := c.cc.Invoke(ctx, "/itineraries.Itineraries/EditComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesClient) DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/itineraries.Itineraries/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesClient) SetItineraryMedia(ctx context.Context, in *SetItineraryMediaReq, opts ...grpc.CallOption) (*ItineraryMediaRes, error) {
+	out := new(ItineraryMediaRes)
+	err := c.cc.Invoke(ctx, "/itineraries.Itineraries/SetItineraryMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ItinerariesServer is the server API for Itineraries service.
 // All implementations must embed UnimplementedItinerariesServer
 // for forward compatibility
@@ -102,6 +132,9 @@ type ItinerariesServer interface {
 	GetItineraries(context.Context, *GetItinerariesReq) (*GetItinerariesRes, error)
 	GetItinerariesById(context.Context, *StoryId) (*GetItinerariesByIdRes, error)
 	CommentItineraries(context.Context, *CommentItinerariesReq) (*CommentItinerariesRes, error)
+	EditComment(context.Context, *EditCommentReq) (*CommentItinerariesRes, error)
+	DeleteComment(context.Context, *DeleteCommentReq) (*Void, error)
+	SetItineraryMedia(context.Context, *SetItineraryMediaReq) (*ItineraryMediaRes, error)
 	mustEmbedUnimplementedItinerariesServer()
 }
 
@@ -127,6 +160,15 @@ func (UnimplementedItinerariesServer) GetItinerariesById(context.Context, *Story
 func (UnimplementedItinerariesServer) CommentItineraries(context.Context, *CommentItinerariesReq) (*CommentItinerariesRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommentItineraries not implemented")
 }
+func (UnimplementedItinerariesServer) EditComment(context.Context, *EditCommentReq) (*CommentItinerariesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedItinerariesServer) DeleteComment(context.Context, *DeleteCommentReq) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedItinerariesServer) SetItineraryMedia(context.Context, *SetItineraryMediaReq) (*ItineraryMediaRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetItineraryMedia not implemented")
+}
 func (UnimplementedItinerariesServer) mustEmbedUnimplementedItinerariesServer() {}
 
 // UnsafeItinerariesServer may be embedded to opt out of forward compatibility for this service.
@@ -248,6 +290,60 @@ func _Itineraries_CommentItineraries_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Itineraries_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries.Itineraries/EditComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServer).EditComment(ctx, req.(*EditCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Itineraries_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries.Itineraries/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServer).DeleteComment(ctx, req.(*DeleteCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Itineraries_SetItineraryMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItineraryMediaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServer).SetItineraryMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries.Itineraries/SetItineraryMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServer).SetItineraryMedia(ctx, req.(*SetItineraryMediaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Itineraries_ServiceDesc is the grpc.ServiceDesc for Itineraries service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,6 +375,18 @@ var Itineraries_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommentItineraries",
 			Handler:    _Itineraries_CommentItineraries_Handler,
 		},
+		{
+			MethodName: "EditComment",
+			Handler:    _Itineraries_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Itineraries_DeleteComment_Handler,
+		},
+		{
+			MethodName: "SetItineraryMedia",
+			Handler:    _Itineraries_SetItineraryMedia_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "itineraries.proto",
@@ -27,9 +27,42 @@ type StoryClient interface {
 	DeleteStories(ctx context.Context, in *StoryId, opts ...grpc.CallOption) (*Void, error)
 	GetAllStories(ctx context.Context, in *GetAllStoriesReq, opts ...grpc.CallOption) (*GetAllStoriesRes, error)
 	GetStory(ctx context.Context, in *StoryId, opts ...grpc.CallOption) (*GetStoryRes, error)
+	RecordView(ctx context.Context, in *RecordViewReq, opts ...grpc.CallOption) (*RecordViewRes, error)
 	CommentStory(ctx context.Context, in *CommentStoryReq, opts ...grpc.CallOption) (*CommentStoryRes, error)
 	GetCommentsOfStory(ctx context.Context, in *GetCommentsOfStoryReq, opts ...grpc.CallOption) (*GetCommentsOfStoryRes, error)
+	GetCommentReplies(ctx context.Context, in *GetCommentRepliesReq, opts ...grpc.CallOption) (*GetCommentRepliesRes, error)
+	EditComment(ctx context.Context, in *EditCommentReq, opts ...grpc.CallOption) (*Comments, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*Void, error)
 	Like(ctx context.Context, in *LikeReq, opts ...grpc.CallOption) (*LikeRes, error)
+	Unlike(ctx context.Context, in *LikeReq, opts ...grpc.CallOption) (*UnlikeRes, error)
+	GetStoryLikers(ctx context.Context, in *GetStoryLikersReq, opts ...grpc.CallOption) (*GetStoryLikersRes, error)
+	ChangeStoryStatus(ctx context.Context, in *ChangeStoryStatusReq, opts ...grpc.CallOption) (*StoryStatusRes, error)
+	ListDrafts(ctx context.Context, in *ListDraftsReq, opts ...grpc.CallOption) (*GetAllStoriesRes, error)
+	ListStoryRevisions(ctx context.Context, in *ListStoryRevisionsReq, opts ...grpc.CallOption) (*ListStoryRevisionsRes, error)
+	GetStoryRevision(ctx context.Context, in *GetStoryRevisionReq, opts ...grpc.CallOption) (*StoryRevision, error)
+	DiffStoryRevisions(ctx context.Context, in *DiffStoryRevisionsReq, opts ...grpc.CallOption) (*DiffStoryRevisionsRes, error)
+	RevertStory(ctx context.Context, in *RevertStoryReq, opts ...grpc.CallOption) (*UpdateStoriesRes, error)
+	SearchStories(ctx context.Context, in *SearchStoriesReq, opts ...grpc.CallOption) (*SearchStoriesRes, error)
+	GetStoriesByTag(ctx context.Context, in *GetStoriesByTagReq, opts ...grpc.CallOption) (*GetAllStoriesRes, error)
+	GetTrendingTags(ctx context.Context, in *GetTrendingTagsReq, opts ...grpc.CallOption) (*TagsRes, error)
+	GetTrendingStories(ctx context.Context, in *GetTrendingStoriesReq, opts ...grpc.CallOption) (*GetAllStoriesRes, error)
+	AutocompleteTags(ctx context.Context, in *AutocompleteTagsReq, opts ...grpc.CallOption) (*TagsRes, error)
+	AddStoryTags(ctx context.Context, in *StoryTagsReq, opts ...grpc.CallOption) (*StoryTagsRes, error)
+	RemoveStoryTags(ctx context.Context, in *StoryTagsReq, opts ...grpc.CallOption) (*StoryTagsRes, error)
+	SetStoryMedia(ctx context.Context, in *SetStoryMediaReq, opts ...grpc.CallOption) (*StoryMediaRes, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionReq, opts ...grpc.CallOption) (*Collection, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionReq, opts ...grpc.CallOption) (*Collection, error)
+	DeleteCollection(ctx context.Context, in *CollectionReq, opts ...grpc.CallOption) (*Void, error)
+	ListCollections(ctx context.Context, in *ListCollectionsReq, opts ...grpc.CallOption) (*ListCollectionsRes, error)
+	GetCollection(ctx context.Context, in *GetCollectionReq, opts ...grpc.CallOption) (*GetCollectionRes, error)
+	AddCollectionStory(ctx context.Context, in *CollectionStoryReq, opts ...grpc.CallOption) (*Collection, error)
+	RemoveCollectionStory(ctx context.Context, in *CollectionStoryReq, opts ...grpc.CallOption) (*Collection, error)
+	ReorderCollection(ctx context.Context, in *ReorderCollectionReq, opts ...grpc.CallOption) (*Collection, error)
+	InviteCollaborator(ctx context.Context, in *InviteCollaboratorReq, opts ...grpc.CallOption) (*StoryCollaborator, error)
+	AcceptCollaboration(ctx context.Context, in *CollaborationReq, opts ...grpc.CallOption) (*StoryCollaborator, error)
+	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorReq, opts ...grpc.CallOption) (*Void, error)
+	ListCollaborators(ctx context.Context, in *CollaborationReq, opts ...grpc.CallOption) (*ListCollaboratorsRes, error)
+	GetRelatedStories(ctx context.Context, in *GetRelatedStoriesReq, opts ...grpc.CallOption) (*GetAllStoriesRes, error)
 }
 
 type storyClient struct {
@@ -85,6 +118,15 @@ func (c *storyClient) GetStory(ctx context.Context, in *StoryId, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *storyClient) RecordView(ctx context.Context, in *RecordViewReq, opts ...grpc.CallOption) (*RecordViewRes, error) {
+	out := new(RecordViewRes)
+	err := c.cc.Invoke(ctx, "/story.Story/RecordView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storyClient) CommentStory(ctx context.Context, in *CommentStoryReq, opts ...grpc.CallOption) (*CommentStoryRes, error) {
 	out := new(CommentStoryRes)
 	err := c.cc.Invoke(ctx, "/story.Story/CommentStory", in, out, opts...)
@@ -103,6 +145,33 @@ func (c *storyClient) GetCommentsOfStory(ctx context.Context, in *GetCommentsOfS
 	return out, nil
 }
 
+func (c *storyClient) GetCommentReplies(ctx context.Context, in *GetCommentRepliesReq, opts ...grpc.CallOption) (*GetCommentRepliesRes, error) {
+	out := new(GetCommentRepliesRes)
+	err := c.cc.Invoke(ctx, "/story.Story/GetCommentReplies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) EditComment(ctx context.Context, in *EditCommentReq, opts ...grpc.CallOption) (*Comments, error) {
+	out := new(Comments)
+	err := c.cc.Invoke(ctx, "/story.Story/EditComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/story.Story/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storyClient) Like(ctx context.Context, in *LikeReq, opts ...grpc.CallOption) (*LikeRes, error) {
 	out := new(LikeRes)
 	err := c.cc.Invoke(ctx, "/story.Story/Like", in, out, opts...)
@@ -112,6 +181,267 @@ func (c *storyClient) Like(ctx context.Context, in *LikeReq, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *storyClient) Unlike(ctx context.Context, in *LikeReq, opts ...grpc.CallOption) (*UnlikeRes, error) {
+	out := new(UnlikeRes)
+	err := c.cc.Invoke(ctx, "/story.Story/Unlike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) GetStoryLikers(ctx context.Context, in *GetStoryLikersReq, opts ...grpc.CallOption) (*GetStoryLikersRes, error) {
+	out := new(GetStoryLikersRes)
+	err := c.cc.Invoke(ctx, "/story.Story/GetStoryLikers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) ChangeStoryStatus(ctx context.Context, in *ChangeStoryStatusReq, opts ...grpc.CallOption) (*StoryStatusRes, error) {
+	out := new(StoryStatusRes)
+	err := c.cc.Invoke(ctx, "/story.Story/ChangeStoryStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) ListDrafts(ctx context.Context, in *ListDraftsReq, opts ...grpc.CallOption) (*GetAllStoriesRes, error) {
+	out := new(GetAllStoriesRes)
+	err := c.cc.Invoke(ctx, "/story.Story/ListDrafts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) ListStoryRevisions(ctx context.Context, in *ListStoryRevisionsReq, opts ...grpc.CallOption) (*ListStoryRevisionsRes, error) {
+	out := new(ListStoryRevisionsRes)
+	err := c.cc.Invoke(ctx, "/story.Story/ListStoryRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) GetStoryRevision(ctx context.Context, in *GetStoryRevisionReq, opts ...grpc.CallOption) (*StoryRevision, error) {
+	out := new(StoryRevision)
+	err := c.cc.Invoke(ctx, "/story.Story/GetStoryRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) DiffStoryRevisions(ctx context.Context, in *DiffStoryRevisionsReq, opts ...grpc.CallOption) (*DiffStoryRevisionsRes, error) {
+	out := new(DiffStoryRevisionsRes)
+	err := c.cc.Invoke(ctx, "/story.Story/DiffStoryRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) RevertStory(ctx context.Context, in *RevertStoryReq, opts ...grpc.CallOption) (*UpdateStoriesRes, error) {
+	out := new(UpdateStoriesRes)
+	err := c.cc.Invoke(ctx, "/story.Story/RevertStory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) SearchStories(ctx context.Context, in *SearchStoriesReq, opts ...grpc.CallOption) (*SearchStoriesRes, error) {
+	out := new(SearchStoriesRes)
+	err := c.cc.Invoke(ctx, "/story.Story/SearchStories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) GetStoriesByTag(ctx context.Context, in *GetStoriesByTagReq, opts ...grpc.CallOption) (*GetAllStoriesRes, error) {
+	out := new(GetAllStoriesRes)
+	err := c.cc.Invoke(ctx, "/story.Story/GetStoriesByTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) GetTrendingTags(ctx context.Context, in *GetTrendingTagsReq, opts ...grpc.CallOption) (*TagsRes, error) {
+	out := new(TagsRes)
+	err := c.cc.Invoke(ctx, "/story.Story/GetTrendingTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) GetTrendingStories(ctx context.Context, in *GetTrendingStoriesReq, opts ...grpc.CallOption) (*GetAllStoriesRes, error) {
+	out := new(GetAllStoriesRes)
+	err := c.cc.Invoke(ctx, "/story.Story/GetTrendingStories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) AutocompleteTags(ctx context.Context, in *AutocompleteTagsReq, opts ...grpc.CallOption) (*TagsRes, error) {
+	out := new(TagsRes)
+	err := c.cc.Invoke(ctx, "/story.Story/AutocompleteTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) AddStoryTags(ctx context.Context, in *StoryTagsReq, opts ...grpc.CallOption) (*StoryTagsRes, error) {
+	out := new(StoryTagsRes)
+	err := c.cc.Invoke(ctx, "/story.Story/AddStoryTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) RemoveStoryTags(ctx context.Context, in *StoryTagsReq, opts ...grpc.CallOption) (*StoryTagsRes, error) {
+	out := new(StoryTagsRes)
+	err := c.cc.Invoke(ctx, "/story.Story/RemoveStoryTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) SetStoryMedia(ctx context.Context, in *SetStoryMediaReq, opts ...grpc.CallOption) (*StoryMediaRes, error) {
+	out := new(StoryMediaRes)
+	err := c.cc.Invoke(ctx, "/story.Story/SetStoryMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) CreateCollection(ctx context.Context, in *CreateCollectionReq, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, "/story.Story/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) UpdateCollection(ctx context.Context, in *UpdateCollectionReq, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, "/story.Story/UpdateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) DeleteCollection(ctx context.Context, in *CollectionReq, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/story.Story/DeleteCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) ListCollections(ctx context.Context, in *ListCollectionsReq, opts ...grpc.CallOption) (*ListCollectionsRes, error) {
+	out := new(ListCollectionsRes)
+	err := c.cc.Invoke(ctx, "/story.Story/ListCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) GetCollection(ctx context.Context, in *GetCollectionReq, opts ...grpc.CallOption) (*GetCollectionRes, error) {
+	out := new(GetCollectionRes)
+	err := c.cc.Invoke(ctx, "/story.Story/GetCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) AddCollectionStory(ctx context.Context, in *CollectionStoryReq, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, "/story.Story/AddCollectionStory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) RemoveCollectionStory(ctx context.Context, in *CollectionStoryReq, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, "/story.Story/RemoveCollectionStory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) ReorderCollection(ctx context.Context, in *ReorderCollectionReq, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, "/story.Story/ReorderCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) InviteCollaborator(ctx context.Context, in *InviteCollaboratorReq, opts ...grpc.CallOption) (*StoryCollaborator, error) {
+	out := new(StoryCollaborator)
+	err := c.cc.Invoke(ctx, "/story.Story/InviteCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) AcceptCollaboration(ctx context.Context, in *CollaborationReq, opts ...grpc.CallOption) (*StoryCollaborator, error) {
+	out := new(StoryCollaborator)
+	err := c.cc.Invoke(ctx, "/story.Story/AcceptCollaboration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorReq, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/story.Story/RemoveCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) ListCollaborators(ctx context.Context, in *CollaborationReq, opts ...grpc.CallOption) (*ListCollaboratorsRes, error) {
+	out := new(ListCollaboratorsRes)
+	err := c.cc.Invoke(ctx, "/story.Story/ListCollaborators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storyClient) GetRelatedStories(ctx context.Context, in *GetRelatedStoriesReq, opts ...grpc.CallOption) (*GetAllStoriesRes, error) {
+	out := new(GetAllStoriesRes)
+	err := c.cc.Invoke(ctx, "/story.Story/GetRelatedStories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StoryServer is the server API for Story service.
 // All implementations must embed UnimplementedStoryServer
 // for forward compatibility
@@ -121,9 +451,42 @@ type StoryServer interface {
 	DeleteStories(context.Context, *StoryId) (*Void, error)
 	GetAllStories(context.Context, *GetAllStoriesReq) (*GetAllStoriesRes, error)
 	GetStory(context.Context, *StoryId) (*GetStoryRes, error)
+	RecordView(context.Context, *RecordViewReq) (*RecordViewRes, error)
 	CommentStory(context.Context, *CommentStoryReq) (*CommentStoryRes, error)
 	GetCommentsOfStory(context.Context, *GetCommentsOfStoryReq) (*GetCommentsOfStoryRes, error)
+	GetCommentReplies(context.Context, *GetCommentRepliesReq) (*GetCommentRepliesRes, error)
+	EditComment(context.Context, *EditCommentReq) (*Comments, error)
+	DeleteComment(context.Context, *DeleteCommentReq) (*Void, error)
 	Like(context.Context, *LikeReq) (*LikeRes, error)
+	Unlike(context.Context, *LikeReq) (*UnlikeRes, error)
+	GetStoryLikers(context.Context, *GetStoryLikersReq) (*GetStoryLikersRes, error)
+	ChangeStoryStatus(context.Context, *ChangeStoryStatusReq) (*StoryStatusRes, error)
+	ListDrafts(context.Context, *ListDraftsReq) (*GetAllStoriesRes, error)
+	ListStoryRevisions(context.Context, *ListStoryRevisionsReq) (*ListStoryRevisionsRes, error)
+	GetStoryRevision(context.Context, *GetStoryRevisionReq) (*StoryRevision, error)
+	DiffStoryRevisions(context.Context, *DiffStoryRevisionsReq) (*DiffStoryRevisionsRes, error)
+	RevertStory(context.Context, *RevertStoryReq) (*UpdateStoriesRes, error)
+	SearchStories(context.Context, *SearchStoriesReq) (*SearchStoriesRes, error)
+	GetStoriesByTag(context.Context, *GetStoriesByTagReq) (*GetAllStoriesRes, error)
+	GetTrendingTags(context.Context, *GetTrendingTagsReq) (*TagsRes, error)
+	GetTrendingStories(context.Context, *GetTrendingStoriesReq) (*GetAllStoriesRes, error)
+	AutocompleteTags(context.Context, *AutocompleteTagsReq) (*TagsRes, error)
+	AddStoryTags(context.Context, *StoryTagsReq) (*StoryTagsRes, error)
+	RemoveStoryTags(context.Context, *StoryTagsReq) (*StoryTagsRes, error)
+	SetStoryMedia(context.Context, *SetStoryMediaReq) (*StoryMediaRes, error)
+	CreateCollection(context.Context, *CreateCollectionReq) (*Collection, error)
+	UpdateCollection(context.Context, *UpdateCollectionReq) (*Collection, error)
+	DeleteCollection(context.Context, *CollectionReq) (*Void, error)
+	ListCollections(context.Context, *ListCollectionsReq) (*ListCollectionsRes, error)
+	GetCollection(context.Context, *GetCollectionReq) (*GetCollectionRes, error)
+	AddCollectionStory(context.Context, *CollectionStoryReq) (*Collection, error)
+	RemoveCollectionStory(context.Context, *CollectionStoryReq) (*Collection, error)
+	ReorderCollection(context.Context, *ReorderCollectionReq) (*Collection, error)
+	InviteCollaborator(context.Context, *InviteCollaboratorReq) (*StoryCollaborator, error)
+	AcceptCollaboration(context.Context, *CollaborationReq) (*StoryCollaborator, error)
+	RemoveCollaborator(context.Context, *RemoveCollaboratorReq) (*Void, error)
+	ListCollaborators(context.Context, *CollaborationReq) (*ListCollaboratorsRes, error)
+	GetRelatedStories(context.Context, *GetRelatedStoriesReq) (*GetAllStoriesRes, error)
 	mustEmbedUnimplementedStoryServer()
 }
 
@@ -146,15 +509,114 @@ func (UnimplementedStoryServer) GetAllStories(context.Context, *GetAllStoriesReq
 func (UnimplementedStoryServer) GetStory(context.Context, *StoryId) (*GetStoryRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStory not implemented")
 }
+func (UnimplementedStoryServer) RecordView(context.Context, *RecordViewReq) (*RecordViewRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordView not implemented")
+}
 func (UnimplementedStoryServer) CommentStory(context.Context, *CommentStoryReq) (*CommentStoryRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommentStory not implemented")
 }
 func (UnimplementedStoryServer) GetCommentsOfStory(context.Context, *GetCommentsOfStoryReq) (*GetCommentsOfStoryRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsOfStory not implemented")
 }
+func (UnimplementedStoryServer) GetCommentReplies(context.Context, *GetCommentRepliesReq) (*GetCommentRepliesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentReplies not implemented")
+}
+func (UnimplementedStoryServer) EditComment(context.Context, *EditCommentReq) (*Comments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedStoryServer) DeleteComment(context.Context, *DeleteCommentReq) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
 func (UnimplementedStoryServer) Like(context.Context, *LikeReq) (*LikeRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Like not implemented")
 }
+func (UnimplementedStoryServer) Unlike(context.Context, *LikeReq) (*UnlikeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlike not implemented")
+}
+func (UnimplementedStoryServer) GetStoryLikers(context.Context, *GetStoryLikersReq) (*GetStoryLikersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoryLikers not implemented")
+}
+func (UnimplementedStoryServer) ChangeStoryStatus(context.Context, *ChangeStoryStatusReq) (*StoryStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeStoryStatus not implemented")
+}
+func (UnimplementedStoryServer) ListDrafts(context.Context, *ListDraftsReq) (*GetAllStoriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedStoryServer) ListStoryRevisions(context.Context, *ListStoryRevisionsReq) (*ListStoryRevisionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoryRevisions not implemented")
+}
+func (UnimplementedStoryServer) GetStoryRevision(context.Context, *GetStoryRevisionReq) (*StoryRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoryRevision not implemented")
+}
+func (UnimplementedStoryServer) DiffStoryRevisions(context.Context, *DiffStoryRevisionsReq) (*DiffStoryRevisionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffStoryRevisions not implemented")
+}
+func (UnimplementedStoryServer) RevertStory(context.Context, *RevertStoryReq) (*UpdateStoriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertStory not implemented")
+}
+func (UnimplementedStoryServer) SearchStories(context.Context, *SearchStoriesReq) (*SearchStoriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStories not implemented")
+}
+func (UnimplementedStoryServer) GetStoriesByTag(context.Context, *GetStoriesByTagReq) (*GetAllStoriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoriesByTag not implemented")
+}
+func (UnimplementedStoryServer) GetTrendingTags(context.Context, *GetTrendingTagsReq) (*TagsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingTags not implemented")
+}
+func (UnimplementedStoryServer) GetTrendingStories(context.Context, *GetTrendingStoriesReq) (*GetAllStoriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingStories not implemented")
+}
+func (UnimplementedStoryServer) AutocompleteTags(context.Context, *AutocompleteTagsReq) (*TagsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteTags not implemented")
+}
+func (UnimplementedStoryServer) AddStoryTags(context.Context, *StoryTagsReq) (*StoryTagsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStoryTags not implemented")
+}
+func (UnimplementedStoryServer) RemoveStoryTags(context.Context, *StoryTagsReq) (*StoryTagsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStoryTags not implemented")
+}
+func (UnimplementedStoryServer) SetStoryMedia(context.Context, *SetStoryMediaReq) (*StoryMediaRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStoryMedia not implemented")
+}
+func (UnimplementedStoryServer) CreateCollection(context.Context, *CreateCollectionReq) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedStoryServer) UpdateCollection(context.Context, *UpdateCollectionReq) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedStoryServer) DeleteCollection(context.Context, *CollectionReq) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedStoryServer) ListCollections(context.Context, *ListCollectionsReq) (*ListCollectionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedStoryServer) GetCollection(context.Context, *GetCollectionReq) (*GetCollectionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedStoryServer) AddCollectionStory(context.Context, *CollectionStoryReq) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionStory not implemented")
+}
+func (UnimplementedStoryServer) RemoveCollectionStory(context.Context, *CollectionStoryReq) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollectionStory not implemented")
+}
+func (UnimplementedStoryServer) ReorderCollection(context.Context, *ReorderCollectionReq) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCollection not implemented")
+}
+func (UnimplementedStoryServer) InviteCollaborator(context.Context, *InviteCollaboratorReq) (*StoryCollaborator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteCollaborator not implemented")
+}
+func (UnimplementedStoryServer) AcceptCollaboration(context.Context, *CollaborationReq) (*StoryCollaborator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCollaboration not implemented")
+}
+func (UnimplementedStoryServer) RemoveCollaborator(context.Context, *RemoveCollaboratorReq) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedStoryServer) ListCollaborators(context.Context, *CollaborationReq) (*ListCollaboratorsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
+}
+func (UnimplementedStoryServer) GetRelatedStories(context.Context, *GetRelatedStoriesReq) (*GetAllStoriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedStories not implemented")
+}
 func (UnimplementedStoryServer) mustEmbedUnimplementedStoryServer() {}
 
 // UnsafeStoryServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +720,24 @@ func _Story_GetStory_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Story_RecordView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordViewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).RecordView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/RecordView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).RecordView(ctx, req.(*RecordViewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Story_CommentStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CommentStoryReq)
 	if err := dec(in); err != nil {
@@ -294,17 +774,71 @@ func _Story_GetCommentsOfStory_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Story_Like_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikeReq)
+func _Story_GetCommentReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentRepliesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoryServer).Like(ctx, in)
+		return srv.(StoryServer).GetCommentReplies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/story.Story/Like",
+		FullMethod: "/story.Story/GetCommentReplies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).GetCommentReplies(ctx, req.(*GetCommentRepliesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/EditComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).EditComment(ctx, req.(*EditCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).DeleteComment(ctx, req.(*DeleteCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_Like_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).Like(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/Like",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(StoryServer).Like(ctx, req.(*LikeReq))
@@ -312,6 +846,528 @@ func _Story_Like_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Story_Unlike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).Unlike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/Unlike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).Unlike(ctx, req.(*LikeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_GetStoryLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoryLikersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).GetStoryLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/GetStoryLikers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).GetStoryLikers(ctx, req.(*GetStoryLikersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_ChangeStoryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeStoryStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).ChangeStoryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/ChangeStoryStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).ChangeStoryStatus(ctx, req.(*ChangeStoryStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/ListDrafts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).ListDrafts(ctx, req.(*ListDraftsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_ListStoryRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoryRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).ListStoryRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/ListStoryRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).ListStoryRevisions(ctx, req.(*ListStoryRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_GetStoryRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoryRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).GetStoryRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/GetStoryRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).GetStoryRevision(ctx, req.(*GetStoryRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_DiffStoryRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffStoryRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).DiffStoryRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/DiffStoryRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).DiffStoryRevisions(ctx, req.(*DiffStoryRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_RevertStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertStoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).RevertStory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/RevertStory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).RevertStory(ctx, req.(*RevertStoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_SearchStories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).SearchStories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/SearchStories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).SearchStories(ctx, req.(*SearchStoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_GetStoriesByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoriesByTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).GetStoriesByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/GetStoriesByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).GetStoriesByTag(ctx, req.(*GetStoriesByTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_GetTrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).GetTrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/GetTrendingTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).GetTrendingTags(ctx, req.(*GetTrendingTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_GetTrendingStories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingStoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).GetTrendingStories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/GetTrendingStories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).GetTrendingStories(ctx, req.(*GetTrendingStoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_AutocompleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).AutocompleteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/AutocompleteTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).AutocompleteTags(ctx, req.(*AutocompleteTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_AddStoryTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoryTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).AddStoryTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/AddStoryTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).AddStoryTags(ctx, req.(*StoryTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_RemoveStoryTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoryTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).RemoveStoryTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/RemoveStoryTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).RemoveStoryTags(ctx, req.(*StoryTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_SetStoryMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStoryMediaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).SetStoryMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/SetStoryMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).SetStoryMedia(ctx, req.(*SetStoryMediaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).CreateCollection(ctx, req.(*CreateCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/UpdateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).UpdateCollection(ctx, req.(*UpdateCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/DeleteCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).DeleteCollection(ctx, req.(*CollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/ListCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).ListCollections(ctx, req.(*ListCollectionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/GetCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).GetCollection(ctx, req.(*GetCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_AddCollectionStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionStoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).AddCollectionStory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/AddCollectionStory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).AddCollectionStory(ctx, req.(*CollectionStoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_RemoveCollectionStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionStoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).RemoveCollectionStory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/RemoveCollectionStory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).RemoveCollectionStory(ctx, req.(*CollectionStoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_ReorderCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).ReorderCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/ReorderCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).ReorderCollection(ctx, req.(*ReorderCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_InviteCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteCollaboratorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).InviteCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/InviteCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).InviteCollaborator(ctx, req.(*InviteCollaboratorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_AcceptCollaboration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollaborationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).AcceptCollaboration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/AcceptCollaboration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).AcceptCollaboration(ctx, req.(*CollaborationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).RemoveCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/RemoveCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).RemoveCollaborator(ctx, req.(*RemoveCollaboratorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollaborationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).ListCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/ListCollaborators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).ListCollaborators(ctx, req.(*CollaborationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Story_GetRelatedStories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedStoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServer).GetRelatedStories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/story.Story/GetRelatedStories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServer).GetRelatedStories(ctx, req.(*GetRelatedStoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Story_ServiceDesc is the grpc.ServiceDesc for Story service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -339,6 +1395,10 @@ var Story_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStory",
 			Handler:    _Story_GetStory_Handler,
 		},
+		{
+			MethodName: "RecordView",
+			Handler:    _Story_RecordView_Handler,
+		},
 		{
 			MethodName: "CommentStory",
 			Handler:    _Story_CommentStory_Handler,
@@ -347,10 +1407,138 @@ var Story_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommentsOfStory",
 			Handler:    _Story_GetCommentsOfStory_Handler,
 		},
+		{
+			MethodName: "GetCommentReplies",
+			Handler:    _Story_GetCommentReplies_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _Story_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Story_DeleteComment_Handler,
+		},
 		{
 			MethodName: "Like",
 			Handler:    _Story_Like_Handler,
 		},
+		{
+			MethodName: "Unlike",
+			Handler:    _Story_Unlike_Handler,
+		},
+		{
+			MethodName: "GetStoryLikers",
+			Handler:    _Story_GetStoryLikers_Handler,
+		},
+		{
+			MethodName: "ChangeStoryStatus",
+			Handler:    _Story_ChangeStoryStatus_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _Story_ListDrafts_Handler,
+		},
+		{
+			MethodName: "ListStoryRevisions",
+			Handler:    _Story_ListStoryRevisions_Handler,
+		},
+		{
+			MethodName: "GetStoryRevision",
+			Handler:    _Story_GetStoryRevision_Handler,
+		},
+		{
+			MethodName: "DiffStoryRevisions",
+			Handler:    _Story_DiffStoryRevisions_Handler,
+		},
+		{
+			MethodName: "RevertStory",
+			Handler:    _Story_RevertStory_Handler,
+		},
+		{
+			MethodName: "SearchStories",
+			Handler:    _Story_SearchStories_Handler,
+		},
+		{
+			MethodName: "GetStoriesByTag",
+			Handler:    _Story_GetStoriesByTag_Handler,
+		},
+		{
+			MethodName: "GetTrendingTags",
+			Handler:    _Story_GetTrendingTags_Handler,
+		},
+		{
+			MethodName: "GetTrendingStories",
+			Handler:    _Story_GetTrendingStories_Handler,
+		},
+		{
+			MethodName: "AutocompleteTags",
+			Handler:    _Story_AutocompleteTags_Handler,
+		},
+		{
+			MethodName: "AddStoryTags",
+			Handler:    _Story_AddStoryTags_Handler,
+		},
+		{
+			MethodName: "RemoveStoryTags",
+			Handler:    _Story_RemoveStoryTags_Handler,
+		},
+		{
+			MethodName: "SetStoryMedia",
+			Handler:    _Story_SetStoryMedia_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _Story_CreateCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _Story_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _Story_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _Story_ListCollections_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _Story_GetCollection_Handler,
+		},
+		{
+			MethodName: "AddCollectionStory",
+			Handler:    _Story_AddCollectionStory_Handler,
+		},
+		{
+			MethodName: "RemoveCollectionStory",
+			Handler:    _Story_RemoveCollectionStory_Handler,
+		},
+		{
+			MethodName: "ReorderCollection",
+			Handler:    _Story_ReorderCollection_Handler,
+		},
+		{
+			MethodName: "InviteCollaborator",
+			Handler:    _Story_InviteCollaborator_Handler,
+		},
+		{
+			MethodName: "AcceptCollaboration",
+			Handler:    _Story_AcceptCollaboration_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _Story_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _Story_ListCollaborators_Handler,
+		},
+		{
+			MethodName: "GetRelatedStories",
+			Handler:    _Story_GetRelatedStories_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stories.proto",
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS stories_search_idx;
+DROP TRIGGER IF EXISTS story_tags_search_update ON story_tags;
+DROP TRIGGER IF EXISTS stories_search_update ON stories;
+DROP FUNCTION IF EXISTS story_tags_search_trigger();
+DROP FUNCTION IF EXISTS stories_search_trigger();
+DROP FUNCTION IF EXISTS story_search_vector(UUID, TEXT, TEXT, TEXT);
+ALTER TABLE stories DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,47 @@
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+CREATE OR REPLACE FUNCTION story_search_vector(p_id UUID, p_title TEXT, p_content TEXT, p_location TEXT)
+RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector('english', coalesce(p_title, '')), 'A') ||
+           setweight(to_tsvector('english', coalesce(p_content, '')), 'B') ||
+           setweight(to_tsvector('english', coalesce(p_location, '')), 'C') ||
+           setweight(to_tsvector('english', coalesce(
+               (SELECT string_agg(tag, ' ') FROM story_tags WHERE story_id = p_id), '')), 'C')
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION stories_search_trigger() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector := story_search_vector(NEW.id, NEW.title, NEW.content, NEW.location);
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stories_search_update
+    BEFORE INSERT OR UPDATE OF title, content, location ON stories
+    FOR EACH ROW EXECUTE FUNCTION stories_search_trigger();
+
+CREATE OR REPLACE FUNCTION story_tags_search_trigger() RETURNS trigger AS $$
+DECLARE
+    target UUID;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        target := OLD.story_id;
+    ELSE
+        target := NEW.story_id;
+    END IF;
+
+    UPDATE stories
+    SET search_vector = story_search_vector(id, title, content, location)
+    WHERE id = target;
+
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER story_tags_search_update
+    AFTER INSERT OR DELETE ON story_tags
+    FOR EACH ROW EXECUTE FUNCTION story_tags_search_trigger();
+
+UPDATE stories SET search_vector = story_search_vector(id, title, content, location);
+
+CREATE INDEX IF NOT EXISTS stories_search_idx ON stories USING GIN (search_vector);
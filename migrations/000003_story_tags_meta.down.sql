@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS story_tags_created_at_idx;
+DROP INDEX IF EXISTS story_tags_tag_idx;
+ALTER TABLE story_tags DROP COLUMN IF EXISTS created_at;
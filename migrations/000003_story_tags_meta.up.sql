@@ -0,0 +1,17 @@
+ALTER TABLE story_tags ADD COLUMN IF NOT EXISTS created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+
+-- Bring existing tags to the normalized form written by the service.
+DELETE FROM story_tags a
+USING story_tags b
+WHERE a.story_id = b.story_id
+  AND a.tag > b.tag
+  AND trim(BOTH '-' FROM regexp_replace(lower(trim(a.tag)), '[^[:alnum:]]+', '-', 'g')) =
+      trim(BOTH '-' FROM regexp_replace(lower(trim(b.tag)), '[^[:alnum:]]+', '-', 'g'));
+
+UPDATE story_tags
+SET tag = trim(BOTH '-' FROM regexp_replace(lower(trim(tag)), '[^[:alnum:]]+', '-', 'g'));
+
+DELETE FROM story_tags WHERE tag = '';
+
+CREATE INDEX IF NOT EXISTS story_tags_tag_idx ON story_tags (tag text_pattern_ops);
+CREATE INDEX IF NOT EXISTS story_tags_created_at_idx ON story_tags (created_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS comments_parent_idx;
+DROP INDEX IF EXISTS comments_story_top_level_idx;
+ALTER TABLE comments DROP COLUMN IF EXISTS replies_count;
+ALTER TABLE comments DROP COLUMN IF EXISTS parent_comment_id;
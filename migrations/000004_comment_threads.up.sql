@@ -0,0 +1,5 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS parent_comment_id UUID REFERENCES comments(id);
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS replies_count INTEGER DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS comments_story_top_level_idx ON comments (story_id, created_at) WHERE parent_comment_id IS NULL;
+CREATE INDEX IF NOT EXISTS comments_parent_idx ON comments (parent_comment_id, created_at);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS moderators (
+    user_id UUID PRIMARY KEY REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS deleted_at BIGINT DEFAULT 0;
+
+ALTER TABLE comment ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE comment ADD COLUMN IF NOT EXISTS deleted_at BIGINT DEFAULT 0;
+
+-- CommentItineraries never maintained the counter before.
+UPDATE itineraries i
+SET comments_count = (SELECT COUNT(*) FROM comment c WHERE c.itinerary_id = i.id AND c.deleted_at = 0);
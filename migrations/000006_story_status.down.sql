@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS stories_author_status_idx;
+DROP INDEX IF EXISTS stories_published_idx;
+DROP INDEX IF EXISTS stories_scheduled_idx;
+ALTER TABLE stories DROP COLUMN IF EXISTS published_at;
+ALTER TABLE stories DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE stories DROP COLUMN IF EXISTS status;
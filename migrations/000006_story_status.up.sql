@@ -0,0 +1,10 @@
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'published'
+    CHECK (status IN ('draft', 'scheduled', 'published', 'archived'));
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS published_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE stories SET published_at = created_at WHERE published_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS stories_scheduled_idx ON stories (publish_at) WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS stories_published_idx ON stories (published_at DESC) WHERE status = 'published' AND deleted_at = 0;
+CREATE INDEX IF NOT EXISTS stories_author_status_idx ON stories (author_id, status);
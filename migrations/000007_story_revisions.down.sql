@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS story_revisions;
+ALTER TABLE stories DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE stories DROP COLUMN IF EXISTS revision;
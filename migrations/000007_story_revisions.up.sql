@@ -0,0 +1,13 @@
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS revision INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS updated_by UUID REFERENCES users(id);
+
+CREATE TABLE IF NOT EXISTS story_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    story_id UUID REFERENCES stories(id),
+    revision INTEGER NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    content TEXT NOT NULL,
+    editor_id UUID REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (story_id, revision)
+);
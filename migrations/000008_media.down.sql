@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS itinerary_media;
+DROP TABLE IF EXISTS story_media;
+DROP TABLE IF EXISTS media;
@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS media (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id UUID REFERENCES users(id),
+    storage_key VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    width INTEGER DEFAULT 0,
+    height INTEGER DEFAULT 0,
+    checksum CHAR(64) NOT NULL,
+    alt_text VARCHAR(500) DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'ready',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at BIGINT DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS media_owner_idx ON media (owner_id);
+
+CREATE TABLE IF NOT EXISTS story_media (
+    story_id UUID REFERENCES stories(id),
+    media_id UUID REFERENCES media(id),
+    position INTEGER NOT NULL,
+    PRIMARY KEY (story_id, media_id)
+);
+
+CREATE TABLE IF NOT EXISTS itinerary_media (
+    itinerary_id UUID REFERENCES itineraries(id),
+    media_id UUID REFERENCES media(id),
+    position INTEGER NOT NULL,
+    PRIMARY KEY (itinerary_id, media_id)
+);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS media_renditions;
+DROP INDEX IF EXISTS media_unprocessed_idx;
+ALTER TABLE media DROP COLUMN IF EXISTS processed_at;
+ALTER TABLE media DROP COLUMN IF EXISTS processing_started_at;
+ALTER TABLE media DROP COLUMN IF EXISTS processing_error;
+ALTER TABLE media DROP COLUMN IF EXISTS dominant_color;
+ALTER TABLE media DROP COLUMN IF EXISTS keep_location;
+ALTER TABLE media ALTER COLUMN status SET DEFAULT 'ready';
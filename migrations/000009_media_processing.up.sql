@@ -0,0 +1,20 @@
+ALTER TABLE media ALTER COLUMN status SET DEFAULT 'pending';
+ALTER TABLE media ADD COLUMN IF NOT EXISTS keep_location BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE media ADD COLUMN IF NOT EXISTS dominant_color VARCHAR(7) NOT NULL DEFAULT '';
+ALTER TABLE media ADD COLUMN IF NOT EXISTS processing_error TEXT NOT NULL DEFAULT '';
+ALTER TABLE media ADD COLUMN IF NOT EXISTS processing_started_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE media ADD COLUMN IF NOT EXISTS processed_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS media_unprocessed_idx ON media (created_at)
+    WHERE status IN ('pending', 'processing');
+
+CREATE TABLE IF NOT EXISTS media_renditions (
+    media_id UUID REFERENCES media(id),
+    name VARCHAR(20) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    PRIMARY KEY (media_id, name)
+);
@@ -0,0 +1,5 @@
+ALTER TABLE story_revisions DROP COLUMN IF EXISTS content_format;
+
+ALTER TABLE stories DROP COLUMN IF EXISTS content_html_version;
+ALTER TABLE stories DROP COLUMN IF EXISTS content_html;
+ALTER TABLE stories DROP COLUMN IF EXISTS content_format;
@@ -0,0 +1,6 @@
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS content_format VARCHAR(20) NOT NULL DEFAULT 'plain'
+    CHECK (content_format IN ('plain', 'markdown'));
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS content_html TEXT;
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS content_html_version INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE story_revisions ADD COLUMN IF NOT EXISTS content_format VARCHAR(20) NOT NULL DEFAULT 'plain';
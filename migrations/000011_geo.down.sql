@@ -0,0 +1,20 @@
+DROP FUNCTION IF EXISTS geo_distance_km(DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION);
+
+DROP INDEX IF EXISTS destinations_geo_idx;
+DROP INDEX IF EXISTS itinerary_destinations_geo_idx;
+DROP INDEX IF EXISTS stories_geo_idx;
+
+ALTER TABLE destinations DROP CONSTRAINT IF EXISTS destinations_geo_check;
+ALTER TABLE destinations DROP COLUMN IF EXISTS place_name;
+ALTER TABLE destinations DROP COLUMN IF EXISTS longitude;
+ALTER TABLE destinations DROP COLUMN IF EXISTS latitude;
+
+ALTER TABLE itinerary_destinations DROP CONSTRAINT IF EXISTS itinerary_destinations_geo_check;
+ALTER TABLE itinerary_destinations DROP COLUMN IF EXISTS place_name;
+ALTER TABLE itinerary_destinations DROP COLUMN IF EXISTS longitude;
+ALTER TABLE itinerary_destinations DROP COLUMN IF EXISTS latitude;
+
+ALTER TABLE stories DROP CONSTRAINT IF EXISTS stories_geo_check;
+ALTER TABLE stories DROP COLUMN IF EXISTS place_name;
+ALTER TABLE stories DROP COLUMN IF EXISTS longitude;
+ALTER TABLE stories DROP COLUMN IF EXISTS latitude;
@@ -0,0 +1,34 @@
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS place_name VARCHAR(200);
+ALTER TABLE stories ADD CONSTRAINT stories_geo_check
+    CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+ALTER TABLE itinerary_destinations ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE itinerary_destinations ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+ALTER TABLE itinerary_destinations ADD COLUMN IF NOT EXISTS place_name VARCHAR(200);
+ALTER TABLE itinerary_destinations ADD CONSTRAINT itinerary_destinations_geo_check
+    CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+ALTER TABLE destinations ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE destinations ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+ALTER TABLE destinations ADD COLUMN IF NOT EXISTS place_name VARCHAR(200);
+ALTER TABLE destinations ADD CONSTRAINT destinations_geo_check
+    CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+CREATE INDEX IF NOT EXISTS stories_geo_idx ON stories (latitude, longitude)
+    WHERE latitude IS NOT NULL;
+CREATE INDEX IF NOT EXISTS itinerary_destinations_geo_idx ON itinerary_destinations (latitude, longitude)
+    WHERE latitude IS NOT NULL;
+CREATE INDEX IF NOT EXISTS destinations_geo_idx ON destinations (latitude, longitude)
+    WHERE latitude IS NOT NULL;
+
+-- Great-circle distance in kilometres (haversine), kept in sync with pkg/geo.
+CREATE OR REPLACE FUNCTION geo_distance_km(lat1 DOUBLE PRECISION, lng1 DOUBLE PRECISION,
+                                           lat2 DOUBLE PRECISION, lng2 DOUBLE PRECISION)
+RETURNS DOUBLE PRECISION AS $$
+    SELECT 2 * 6371.0 * asin(least(1, sqrt(
+        power(sin(radians(lat2 - lat1) / 2), 2) +
+        cos(radians(lat1)) * cos(radians(lat2)) * power(sin(radians(lng2 - lng1) / 2), 2)
+    )))
+$$ LANGUAGE sql IMMUTABLE STRICT;
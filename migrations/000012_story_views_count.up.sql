@@ -0,0 +1 @@
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS views_count INTEGER NOT NULL DEFAULT 0;
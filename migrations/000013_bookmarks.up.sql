@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS bookmarks (
+    user_id UUID NOT NULL REFERENCES users(id),
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('story', 'itinerary', 'tip')),
+    entity_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, entity_type, entity_id)
+);
+
+CREATE INDEX IF NOT EXISTS bookmarks_entity_idx ON bookmarks (entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS bookmarks_user_created_idx ON bookmarks (user_id, created_at DESC);
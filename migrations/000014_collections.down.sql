@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS collection_stories;
+DROP TABLE IF EXISTS collections;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS collections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id UUID REFERENCES users(id),
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    cover_media_id UUID REFERENCES media(id),
+    visibility VARCHAR(20) NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'unlisted', 'private')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at BIGINT DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS collections_owner_idx ON collections (owner_id, created_at DESC) WHERE deleted_at = 0;
+
+CREATE TABLE IF NOT EXISTS collection_stories (
+    collection_id UUID NOT NULL REFERENCES collections(id),
+    story_id UUID NOT NULL REFERENCES stories(id),
+    position INTEGER NOT NULL,
+    added_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (collection_id, story_id)
+);
+
+CREATE INDEX IF NOT EXISTS collection_stories_story_idx ON collection_stories (story_id);
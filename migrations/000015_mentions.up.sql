@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS mentions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mentioned_user_id UUID NOT NULL REFERENCES users(id),
+    author_id UUID REFERENCES users(id),
+    source_type VARCHAR(20) NOT NULL CHECK (source_type IN ('story', 'story_comment', 'itinerary_comment')),
+    source_id UUID NOT NULL,
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('story', 'itinerary')),
+    target_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (source_type, source_id, mentioned_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS mentions_user_created_idx ON mentions (mentioned_user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS mentions_target_idx ON mentions (target_type, target_id);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS moderation_actions;
+DROP TABLE IF EXISTS reports;
+
+ALTER TABLE messages DROP COLUMN IF EXISTS is_hidden;
+ALTER TABLE travel_tips DROP COLUMN IF EXISTS is_hidden;
+ALTER TABLE comment DROP COLUMN IF EXISTS is_hidden;
+ALTER TABLE comments DROP COLUMN IF EXISTS is_hidden;
+ALTER TABLE stories DROP COLUMN IF EXISTS is_hidden;
@@ -0,0 +1,40 @@
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE comment ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE travel_tips ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type VARCHAR(20) NOT NULL
+        CHECK (entity_type IN ('story', 'story_comment', 'itinerary_comment', 'tip', 'message')),
+    entity_id UUID NOT NULL,
+    reporter_id UUID REFERENCES users(id),
+    reason VARCHAR(20) NOT NULL
+        CHECK (reason IN ('spam', 'harassment', 'hate', 'violence', 'sexual', 'misinformation', 'copyright', 'other')),
+    details TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'resolved', 'dismissed')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    resolved_by UUID REFERENCES users(id),
+    resolution VARCHAR(20)
+);
+
+-- A user has at most one open report per entity.
+CREATE UNIQUE INDEX IF NOT EXISTS reports_open_reporter_idx ON reports (entity_type, entity_id, reporter_id)
+    WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS reports_status_created_idx ON reports (status, created_at);
+
+CREATE TABLE IF NOT EXISTS moderation_actions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    moderator_id UUID REFERENCES users(id),
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    target_user_id UUID REFERENCES users(id),
+    action VARCHAR(20) NOT NULL CHECK (action IN ('auto_hide', 'dismiss', 'hide', 'delete', 'warn')),
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS moderation_actions_entity_idx ON moderation_actions (entity_type, entity_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS moderation_actions_target_idx ON moderation_actions (target_user_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS followers_following_idx;
+
+ALTER TABLE itineraries DROP COLUMN IF EXISTS visibility;
+ALTER TABLE stories DROP COLUMN IF EXISTS visibility;
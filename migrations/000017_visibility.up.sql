@@ -0,0 +1,7 @@
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS visibility VARCHAR(20) NOT NULL DEFAULT 'public'
+    CHECK (visibility IN ('public', 'followers', 'unlisted', 'private'));
+ALTER TABLE itineraries ADD COLUMN IF NOT EXISTS visibility VARCHAR(20) NOT NULL DEFAULT 'public'
+    CHECK (visibility IN ('public', 'followers', 'unlisted', 'private'));
+
+-- Followers-only content is checked by looking up the author's followers.
+CREATE INDEX IF NOT EXISTS followers_following_idx ON followers (following_id, follower_id);
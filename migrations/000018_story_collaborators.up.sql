@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS story_collaborators (
+    story_id UUID NOT NULL REFERENCES stories(id),
+    user_id UUID NOT NULL REFERENCES users(id),
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'editor')),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted')),
+    invited_by UUID REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (story_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS story_collaborators_user_idx ON story_collaborators (user_id) WHERE status = 'accepted';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS stories_reading_stats_version_idx;
+ALTER TABLE stories DROP COLUMN IF EXISTS reading_stats_version;
+ALTER TABLE stories DROP COLUMN IF EXISTS excerpt;
+ALTER TABLE stories DROP COLUMN IF EXISTS reading_minutes;
+ALTER TABLE stories DROP COLUMN IF EXISTS word_count;
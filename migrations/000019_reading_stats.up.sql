@@ -0,0 +1,8 @@
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS word_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS reading_minutes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS excerpt TEXT NOT NULL DEFAULT '';
+
+-- Stories computed by an older version of the reading package, including all
+-- existing ones, are picked up by the backfill job.
+ALTER TABLE stories ADD COLUMN IF NOT EXISTS reading_stats_version INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS stories_reading_stats_version_idx ON stories (reading_stats_version);
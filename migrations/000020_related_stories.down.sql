@@ -0,0 +1 @@
+DROP INDEX IF EXISTS likes_story_idx;
@@ -0,0 +1,2 @@
+-- Related stories are found through the other stories their likers liked.
+CREATE INDEX IF NOT EXISTS likes_story_idx ON likes (story_id, user_id);
@@ -0,0 +1,48 @@
+// Package cursor encodes keyset pagination positions as opaque strings.
+package cursor
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Cursor points at the last item of a page ordered by Score then Key, both
+// descending. The next page starts strictly after it.
+type Cursor struct {
+	Score int64
+	Key   string
+}
+
+func (c Cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.Score, 10) + "|" + c.Key))
+}
+
+// After reports whether an item with the given score and key comes after c.
+func (c Cursor) After(score int64, key string) bool {
+	return score < c.Score || (score == c.Score && key < c.Key)
+}
+
+// Parse decodes a cursor string. An empty string yields nil, the start of the
+// list.
+func Parse(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	score, key, ok := strings.Cut(string(raw), "|")
+	if !ok || key == "" {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	n, err := strconv.ParseInt(score, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	return &Cursor{Score: n, Key: key}, nil
+}
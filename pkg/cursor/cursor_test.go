@@ -0,0 +1,46 @@
+package cursor
+
+import "testing"
+
+func TestRoundTrip(t *testing.T) {
+	c := Cursor{Score: 1718000000123, Key: "story:2b1f|x"}
+
+	got, err := Parse(c.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != c {
+		t.Errorf("Parse(String()) = %+v, want %+v", *got, c)
+	}
+}
+
+func TestParse(t *testing.T) {
+	if c, err := Parse(""); c != nil || err != nil {
+		t.Errorf("Parse(\"\") = %v, %v", c, err)
+	}
+	for _, s := range []string{"***", "bm8tc2VwYXJhdG9y", "YWJjfGtleQ", "MTI"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) should fail", s)
+		}
+	}
+}
+
+func TestAfter(t *testing.T) {
+	c := Cursor{Score: 100, Key: "story:b"}
+	cases := []struct {
+		score int64
+		key   string
+		want  bool
+	}{
+		{99, "story:z", true},
+		{100, "story:a", true},
+		{100, "story:b", false},
+		{100, "story:c", false},
+		{101, "story:a", false},
+	}
+	for _, tc := range cases {
+		if got := c.After(tc.score, tc.key); got != tc.want {
+			t.Errorf("After(%d, %q) = %v, want %v", tc.score, tc.key, got, tc.want)
+		}
+	}
+}
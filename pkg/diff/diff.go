@@ -0,0 +1,102 @@
+package diff
+
+import "strings"
+
+const (
+	OpEqual  = "equal"
+	OpInsert = "insert"
+	OpDelete = "delete"
+)
+
+// Line is one line of a line-level diff. OldLine and NewLine are 1-based line
+// numbers in the old and new text, zero when the line is absent there.
+type Line struct {
+	Op      string
+	Text    string
+	OldLine int
+	NewLine int
+}
+
+// maxCells bounds the size of the table used to find the longest common
+// subsequence of a changed block, and so the time and memory Lines takes.
+const maxCells = 1_000_000
+
+// Lines computes a line-level diff from a to b using the longest common
+// subsequence of their lines. Deletions are listed before insertions within
+// each changed block. A changed block too large to compare line by line is
+// reported as deleted and inserted as a whole.
+func Lines(a, b string) []Line {
+	return diff(splitLines(a), splitLines(b))
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(s, "\r\n", "\n"), "\n"), "\n")
+}
+
+func diff(a, b []string) []Line {
+	var res []Line
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		res = append(res, Line{Op: OpEqual, Text: a[prefix], OldLine: prefix + 1, NewLine: prefix + 1})
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	// lcs[i][j] is the LCS length of ma[i:] and mb[j:]. Without it every
+	// line of ma is deleted before every line of mb is inserted.
+	var lcs [][]int
+	if (len(ma)+1)*(len(mb)+1) <= maxCells {
+		lcs = make([][]int, len(ma)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(mb)+1)
+		}
+		for i := len(ma) - 1; i >= 0; i-- {
+			for j := len(mb) - 1; j >= 0; j-- {
+				if ma[i] == mb[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(ma) || j < len(mb) {
+		switch {
+		case lcs == nil && i < len(ma):
+			res = append(res, Line{Op: OpDelete, Text: ma[i], OldLine: prefix + i + 1})
+			i++
+		case lcs == nil:
+			res = append(res, Line{Op: OpInsert, Text: mb[j], NewLine: prefix + j + 1})
+			j++
+		case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+			res = append(res, Line{Op: OpEqual, Text: ma[i], OldLine: prefix + i + 1, NewLine: prefix + j + 1})
+			i++
+			j++
+		case j == len(mb) || (i < len(ma) && lcs[i+1][j] >= lcs[i][j+1]):
+			res = append(res, Line{Op: OpDelete, Text: ma[i], OldLine: prefix + i + 1})
+			i++
+		default:
+			res = append(res, Line{Op: OpInsert, Text: mb[j], NewLine: prefix + j + 1})
+			j++
+		}
+	}
+
+	for k := 0; k < suffix; k++ {
+		oi, ni := len(a)-suffix+k, len(b)-suffix+k
+		res = append(res, Line{Op: OpEqual, Text: a[oi], OldLine: oi + 1, NewLine: ni + 1})
+	}
+
+	return res
+}
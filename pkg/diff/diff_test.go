@@ -0,0 +1,60 @@
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	old := "Day one\nWe landed in Tashkent\nIt rained\nThe end\n"
+	updated := "Day one\nWe landed in Samarkand\nIt rained\nWe ate plov\nThe end\n"
+
+	want := []Line{
+		{Op: OpEqual, Text: "Day one", OldLine: 1, NewLine: 1},
+		{Op: OpDelete, Text: "We landed in Tashkent", OldLine: 2},
+		{Op: OpInsert, Text: "We landed in Samarkand", NewLine: 2},
+		{Op: OpEqual, Text: "It rained", OldLine: 3, NewLine: 3},
+		{Op: OpInsert, Text: "We ate plov", NewLine: 4},
+		{Op: OpEqual, Text: "The end", OldLine: 4, NewLine: 5},
+	}
+
+	if got := Lines(old, updated); !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines returned %+v, want %+v", got, want)
+	}
+}
+
+func TestLinesEmpty(t *testing.T) {
+	got := Lines("", "a\nb")
+	want := []Line{
+		{Op: OpInsert, Text: "a", NewLine: 1},
+		{Op: OpInsert, Text: "b", NewLine: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines returned %+v, want %+v", got, want)
+	}
+
+	if got := Lines("same", "same"); len(got) != 1 || got[0].Op != OpEqual {
+		t.Errorf("Lines returned %+v for identical input", got)
+	}
+}
+
+func TestLinesLargeBlock(t *testing.T) {
+	var a, b strings.Builder
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(&a, "old %d\n", i)
+		fmt.Fprintf(&b, "new %d\n", i)
+	}
+
+	got := Lines("first\n"+a.String()+"last", "first\n"+b.String()+"last")
+	if len(got) != 4002 {
+		t.Fatalf("Lines returned %d lines, want 4002", len(got))
+	}
+	if got[0].Op != OpEqual || got[len(got)-1] != (Line{Op: OpEqual, Text: "last", OldLine: 2002, NewLine: 2002}) {
+		t.Errorf("Lines did not keep the common prefix and suffix: %+v ... %+v", got[0], got[len(got)-1])
+	}
+	if got[1] != (Line{Op: OpDelete, Text: "old 0", OldLine: 2}) || got[2001] != (Line{Op: OpInsert, Text: "new 0", NewLine: 2}) {
+		t.Errorf("Lines returned %+v and %+v, want the old block deleted before the new one is inserted", got[1], got[2001])
+	}
+}
@@ -0,0 +1,67 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+)
+
+// EarthRadiusKm is the mean earth radius used for all distances.
+const EarthRadiusKm = 6371.0
+
+func Validate(lat, lng float64) error {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return fmt.Errorf("latitude %v is out of range", lat)
+	}
+	if math.IsNaN(lng) || lng < -180 || lng > 180 {
+		return fmt.Errorf("longitude %v is out of range", lng)
+	}
+	return nil
+}
+
+// Distance returns the great-circle distance in kilometres between two points
+// using the haversine formula.
+func Distance(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := radians(lat2 - lat1)
+	dLng := radians(lng2 - lng1)
+	a := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(radians(lat1))*math.Cos(radians(lat2))*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Box is a latitude/longitude rectangle.
+type Box struct {
+	MinLat, MaxLat float64
+	MinLng, MaxLng float64
+}
+
+// BoundingBox returns a box that contains every point within radiusKm of the
+// centre. It is used to prefilter on indexed columns before the exact
+// distance check. Near the poles or across the antimeridian the box spans all
+// longitudes.
+func BoundingBox(lat, lng, radiusKm float64) Box {
+	dLat := degrees(radiusKm / EarthRadiusKm)
+	box := Box{
+		MinLat: math.Max(lat-dLat, -90),
+		MaxLat: math.Min(lat+dLat, 90),
+		MinLng: -180,
+		MaxLng: 180,
+	}
+	if box.MinLat == -90 || box.MaxLat == 90 {
+		return box
+	}
+
+	dLng := degrees(math.Asin(math.Min(1, math.Sin(radiusKm/EarthRadiusKm)/math.Cos(radians(lat)))))
+	if lng-dLng < -180 || lng+dLng > 180 {
+		return box
+	}
+	box.MinLng, box.MaxLng = lng-dLng, lng+dLng
+	return box
+}
+
+func radians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func degrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
@@ -0,0 +1,61 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	// Paris to London is about 344 km.
+	d := Distance(48.8566, 2.3522, 51.5074, -0.1278)
+	if math.Abs(d-343.5) > 1 {
+		t.Errorf("Distance = %.1f km, want about 343.5", d)
+	}
+	if d := Distance(10, 20, 10, 20); d != 0 {
+		t.Errorf("Distance to itself = %v", d)
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	lat, lng, radius := 41.9028, 12.4964, 50.0
+	box := BoundingBox(lat, lng, radius)
+
+	// Points due north, south, east and west at the radius must be inside.
+	for _, bearing := range []float64{0, 90, 180, 270} {
+		plat, plng := destination(lat, lng, radius*0.999, bearing)
+		if plat < box.MinLat || plat > box.MaxLat || plng < box.MinLng || plng > box.MaxLng {
+			t.Errorf("point at bearing %v (%v, %v) outside %+v", bearing, plat, plng, box)
+		}
+	}
+	if box.MaxLng-box.MinLng > 2 {
+		t.Errorf("box is too wide: %+v", box)
+	}
+
+	if box := BoundingBox(89.9, 0, 50); box.MinLng != -180 || box.MaxLng != 180 || box.MaxLat != 90 {
+		t.Errorf("polar box = %+v", box)
+	}
+	if box := BoundingBox(0, 179.9, 50); box.MinLng != -180 || box.MaxLng != 180 {
+		t.Errorf("antimeridian box = %+v", box)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(45, -120); err != nil {
+		t.Error(err)
+	}
+	for _, p := range [][2]float64{{91, 0}, {0, -181}, {math.NaN(), 0}} {
+		if err := Validate(p[0], p[1]); err == nil {
+			t.Errorf("Validate(%v) should fail", p)
+		}
+	}
+}
+
+// destination returns the point distanceKm away from lat/lng along bearing.
+func destination(lat, lng, distanceKm, bearing float64) (float64, float64) {
+	d := distanceKm / EarthRadiusKm
+	b := radians(bearing)
+	lat1, lng1 := radians(lat), radians(lng)
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(b))
+	lng2 := lng1 + math.Atan2(math.Sin(b)*math.Sin(d)*math.Cos(lat1), math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+	return degrees(lat2), degrees(lng2)
+}
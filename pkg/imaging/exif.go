@@ -0,0 +1,171 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	orientationTag = 0x0112
+	gpsIFDTag      = 0x8825
+)
+
+var errCorruptExif = errors.New("corrupt exif data")
+
+// typeSizes holds the byte size of each TIFF field type.
+var typeSizes = map[uint16]uint64{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8,
+}
+
+// findExif returns the TIFF block of the first Exif APP1 segment of a JPEG.
+// The returned slice aliases data.
+func findExif(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, nil
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil, errCorruptExif
+		}
+		marker := data[pos+1]
+		if marker == 0xFF {
+			pos++
+			continue
+		}
+		if marker == 0xDA || marker == 0xD9 {
+			return nil, nil
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return nil, errCorruptExif
+		}
+		segment := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:], nil
+		}
+		pos += 2 + length
+	}
+
+	return nil, nil
+}
+
+// readIFD0 parses the TIFF header and returns the byte order and the offset
+// and entry count of the first IFD.
+func readIFD0(tiff []byte) (binary.ByteOrder, int, int, error) {
+	if len(tiff) < 8 {
+		return nil, 0, 0, errCorruptExif
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, 0, 0, errCorruptExif
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return nil, 0, 0, errCorruptExif
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	if offset+2+12*count+4 > len(tiff) {
+		return nil, 0, 0, errCorruptExif
+	}
+
+	return order, offset, count, nil
+}
+
+// Orientation returns the EXIF orientation (1-8) of a JPEG, or 1 when the
+// image carries none.
+func Orientation(data []byte) int {
+	tiff, err := findExif(data)
+	if err != nil || tiff == nil {
+		return 1
+	}
+	order, offset, count, err := readIFD0(tiff)
+	if err != nil {
+		return 1
+	}
+
+	for i := 0; i < count; i++ {
+		entry := tiff[offset+2+12*i:]
+		if order.Uint16(entry) == orientationTag {
+			if o := int(order.Uint16(entry[8:])); o >= 1 && o <= 8 {
+				return o
+			}
+			return 1
+		}
+	}
+
+	return 1
+}
+
+// StripGPS removes the GPS block from the EXIF data of a JPEG. The GPS fields
+// are zeroed and unlinked from IFD0, everything else is kept byte for byte.
+// It reports whether GPS data was found; data itself is never modified.
+func StripGPS(data []byte) ([]byte, bool, error) {
+	out := append([]byte(nil), data...)
+
+	tiff, err := findExif(out)
+	if err != nil || tiff == nil {
+		return out, false, err
+	}
+	order, offset, count, err := readIFD0(tiff)
+	if err != nil {
+		return nil, false, err
+	}
+
+	end := offset + 2 + 12*count
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + 12*i
+		if order.Uint16(tiff[entry:]) != gpsIFDTag {
+			continue
+		}
+
+		if err := clearIFD(tiff, order, int(order.Uint32(tiff[entry+8:]))); err != nil {
+			return nil, false, err
+		}
+
+		// Shift the remaining entries and the next IFD pointer over the GPS
+		// pointer entry.
+		copy(tiff[entry:end+4], tiff[entry+12:end+4])
+		clear(tiff[end-8 : end+4])
+		order.PutUint16(tiff[offset:], uint16(count-1))
+		return out, true, nil
+	}
+
+	return out, false, nil
+}
+
+func clearIFD(tiff []byte, order binary.ByteOrder, offset int) error {
+	if offset < 8 || offset+2 > len(tiff) {
+		return errCorruptExif
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	end := offset + 2 + 12*count
+	if end > len(tiff) {
+		return errCorruptExif
+	}
+
+	for i := 0; i < count; i++ {
+		entry := tiff[offset+2+12*i:]
+		size := typeSizes[order.Uint16(entry[2:])] * uint64(order.Uint32(entry[4:]))
+		if size <= 4 {
+			continue
+		}
+		value := uint64(order.Uint32(entry[8:]))
+		if value+size <= uint64(len(tiff)) {
+			clear(tiff[value : value+size])
+		}
+	}
+
+	clear(tiff[offset:min(end+4, len(tiff))])
+	return nil
+}
@@ -0,0 +1,176 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// exifJPEG builds a small JPEG whose Exif block holds an orientation, a camera
+// make and a GPS latitude.
+func exifJPEG(t *testing.T) []byte {
+	t.Helper()
+
+	le := binary.LittleEndian
+	tiff := make([]byte, 0, 128)
+	tiff = append(tiff, 'I', 'I', 42, 0, 8, 0, 0, 0)
+
+	// IFD0 at 8 with three entries, next IFD pointer 0.
+	tiff = le.AppendUint16(tiff, 3)
+	tiff = appendEntry(tiff, orientationTag, 3, 1, 6)
+	tiff = appendEntry(tiff, 0x010F, 2, 4, le.Uint32([]byte("Sony")))
+	gpsOffset := uint32(8 + 2 + 3*12 + 4)
+	tiff = appendEntry(tiff, gpsIFDTag, 4, 1, gpsOffset)
+	tiff = le.AppendUint32(tiff, 0)
+
+	// GPS IFD with a latitude stored out of line.
+	latOffset := gpsOffset + 2 + 12 + 4
+	tiff = le.AppendUint16(tiff, 1)
+	tiff = appendEntry(tiff, 0x0002, 5, 3, latOffset)
+	tiff = le.AppendUint32(tiff, 0)
+	for _, v := range []uint32{41, 1, 17, 1, 4321, 100} {
+		tiff = le.AppendUint32(tiff, v)
+	}
+
+	app1 := append([]byte("Exif\x00\x00"), tiff...)
+
+	var img bytes.Buffer
+	if err := jpeg.Encode(&img, image.NewRGBA(image.Rect(0, 0, 8, 4)), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte{0xFF, 0xD8, 0xFF, 0xE1}
+	data = binary.BigEndian.AppendUint16(data, uint16(len(app1)+2))
+	data = append(data, app1...)
+	return append(data, img.Bytes()[2:]...)
+}
+
+func appendEntry(b []byte, tag, typ uint16, count, value uint32) []byte {
+	le := binary.LittleEndian
+	b = le.AppendUint16(b, tag)
+	b = le.AppendUint16(b, typ)
+	b = le.AppendUint32(b, count)
+	return le.AppendUint32(b, value)
+}
+
+func TestStripGPS(t *testing.T) {
+	data := exifJPEG(t)
+	original := append([]byte(nil), data...)
+
+	stripped, found, err := StripGPS(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found {
+		t.Fatal("expected GPS data to be found")
+	}
+	if !bytes.Equal(data, original) {
+		t.Error("StripGPS modified its input")
+	}
+	if len(stripped) != len(data) {
+		t.Errorf("length changed from %d to %d", len(data), len(stripped))
+	}
+
+	lat := binary.LittleEndian.AppendUint32(nil, 4321)
+	if bytes.Contains(stripped, lat) {
+		t.Error("latitude is still present")
+	}
+	if !bytes.Contains(stripped, []byte("Sony")) {
+		t.Error("camera make was removed")
+	}
+	if got := Orientation(stripped); got != 6 {
+		t.Errorf("orientation = %d, want 6", got)
+	}
+	if _, err := jpeg.Decode(bytes.NewReader(stripped)); err != nil {
+		t.Errorf("stripped image does not decode: %v", err)
+	}
+
+	if _, found, err := StripGPS(stripped); err != nil || found {
+		t.Errorf("second pass found=%v err=%v", found, err)
+	}
+}
+
+func TestStripGPSWithoutExif(t *testing.T) {
+	for _, data := range [][]byte{nil, []byte("not a jpeg"), {0xFF, 0xD8, 0xFF, 0xD9}} {
+		out, found, err := StripGPS(data)
+		if err != nil || found || !bytes.Equal(out, data) {
+			t.Errorf("StripGPS(%q) = %q, %v, %v", data, out, found, err)
+		}
+	}
+}
+
+func TestOrient(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	red := color.RGBA{R: 255, A: 255}
+	src.Set(0, 0, red)
+
+	cases := []struct {
+		orientation int
+		w, h        int
+		x, y        int
+	}{
+		{1, 3, 2, 0, 0},
+		{3, 3, 2, 2, 1},
+		{6, 2, 3, 1, 0},
+		{8, 2, 3, 0, 2},
+	}
+	for _, c := range cases {
+		dst := Orient(src, c.orientation)
+		if b := dst.Bounds(); b.Dx() != c.w || b.Dy() != c.h {
+			t.Errorf("orientation %d: got %dx%d, want %dx%d", c.orientation, b.Dx(), b.Dy(), c.w, c.h)
+			continue
+		}
+		if got := color.RGBAModel.Convert(dst.At(c.x, c.y)); got != red {
+			t.Errorf("orientation %d: pixel (%d,%d) = %v, want red", c.orientation, c.x, c.y, got)
+		}
+	}
+}
+
+func TestFit(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 400, 200))
+	for x := 0; x < 400; x++ {
+		for y := 0; y < 200; y++ {
+			if x%2 == 0 {
+				src.Set(x, y, color.White)
+			} else {
+				src.Set(x, y, color.Black)
+			}
+		}
+	}
+
+	dst := Fit(src, 100, 100)
+	if b := dst.Bounds(); b.Dx() != 100 || b.Dy() != 50 {
+		t.Fatalf("got %dx%d, want 100x50", b.Dx(), b.Dy())
+	}
+	r, _, _, _ := dst.At(10, 10).RGBA()
+	if v := r >> 8; v < 120 || v > 135 {
+		t.Errorf("expected an averaged grey, got %d", v)
+	}
+
+	if Fit(src, 1000, 1000) != image.Image(src) {
+		t.Error("an image that fits should be returned unchanged")
+	}
+}
+
+func TestDominantColor(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	for x := 0; x < 10; x++ {
+		for y := 0; y < 10; y++ {
+			c := color.RGBA{R: 20, G: 120, B: 200, A: 255}
+			if y < 3 {
+				c = color.RGBA{R: 250, G: 250, B: 250, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+
+	if got := DominantColor(img); got != "#1478c8" {
+		t.Errorf("DominantColor = %q, want #1478c8", got)
+	}
+	if got := DominantColor(image.NewRGBA(image.Rect(0, 0, 4, 4))); got != "" {
+		t.Errorf("transparent image gave %q", got)
+	}
+}
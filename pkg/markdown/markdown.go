@@ -0,0 +1,611 @@
+// Package markdown renders story content to HTML that is safe to embed.
+//
+// The renderer never passes raw HTML through: all text is escaped and only
+// the elements below are produced, so the output is sanitized by
+// construction.
+//
+//	p, h1-h6, blockquote, ul, ol, li, pre, code, em, strong, del, hr, br,
+//	a (href, rel), img (src, alt)
+//
+// Link targets must be relative or use http, https or mailto; image sources
+// must use http or https. Anything else is rendered as plain text.
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"strings"
+)
+
+// Version identifies the renderer output. Bump it whenever the produced HTML
+// changes so cached renderings are refreshed.
+const Version = 1
+
+// Render converts Markdown source to sanitized HTML.
+func Render(src string) string {
+	var b strings.Builder
+	renderBlocks(&b, splitLines(src))
+	return b.String()
+}
+
+// RenderPlain converts plain text to HTML: blank lines separate paragraphs
+// and single line breaks are kept.
+func RenderPlain(src string) string {
+	var b strings.Builder
+	for _, para := range strings.Split(strings.Join(splitLines(src), "\n"), "\n\n") {
+		para = strings.Trim(para, "\n")
+		if strings.TrimSpace(para) == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(para), "\n", "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
+
+func splitLines(src string) []string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\t", "    ")
+	return strings.Split(src, "\n")
+}
+
+func renderBlocks(b *strings.Builder, lines []string) {
+	for i := 0; i < len(lines); {
+		trimmed := strings.TrimSpace(lines[i])
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case isFence(trimmed):
+			i = renderFence(b, lines, i)
+
+		case headingLevel(trimmed) > 0:
+			level := headingLevel(trimmed)
+			text := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(trimmed[level:]), "#"))
+			tag := "h" + string(rune('0'+level))
+			b.WriteString("<" + tag + ">")
+			renderInline(b, text)
+			b.WriteString("</" + tag + ">\n")
+			i++
+
+		case isRule(trimmed):
+			b.WriteString("<hr>\n")
+			i++
+
+		case strings.HasPrefix(trimmed, ">"):
+			var quoted []string
+			for ; i < len(lines); i++ {
+				t := strings.TrimSpace(lines[i])
+				if !strings.HasPrefix(t, ">") {
+					break
+				}
+				quoted = append(quoted, strings.TrimPrefix(t[1:], " "))
+			}
+			b.WriteString("<blockquote>\n")
+			renderBlocks(b, quoted)
+			b.WriteString("</blockquote>\n")
+
+		case listMarker(trimmed) != "":
+			i = renderList(b, lines, i)
+
+		default:
+			var para []string
+			for ; i < len(lines) && !startsBlock(lines[i]); i++ {
+				para = append(para, lines[i])
+			}
+			b.WriteString("<p>")
+			renderParagraph(b, para)
+			b.WriteString("</p>\n")
+		}
+	}
+}
+
+// startsBlock reports whether line ends a running paragraph.
+func startsBlock(line string) bool {
+	t := strings.TrimSpace(line)
+	return t == "" || isFence(t) || headingLevel(t) > 0 || isRule(t) ||
+		strings.HasPrefix(t, ">") || listMarker(t) != ""
+}
+
+func isFence(t string) bool {
+	return strings.HasPrefix(t, "```") || strings.HasPrefix(t, "~~~")
+}
+
+func renderFence(b *strings.Builder, lines []string, i int) int {
+	fence := strings.TrimSpace(lines[i])[:3]
+	indent := len(lines[i]) - len(strings.TrimLeft(lines[i], " "))
+
+	var code []string
+	for i++; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+			i++
+			break
+		}
+		line := lines[i]
+		for n := 0; n < indent && strings.HasPrefix(line, " "); n++ {
+			line = line[1:]
+		}
+		code = append(code, line)
+	}
+
+	b.WriteString("<pre><code>")
+	for _, line := range code {
+		b.WriteString(html.EscapeString(line))
+		b.WriteString("\n")
+	}
+	b.WriteString("</code></pre>\n")
+	return i
+}
+
+func headingLevel(t string) int {
+	level := 0
+	for level < len(t) && t[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(t) && t[level] != ' ') {
+		return 0
+	}
+	return level
+}
+
+func isRule(t string) bool {
+	if len(t) < 3 || !strings.ContainsRune("-*_", rune(t[0])) {
+		return false
+	}
+	count := 0
+	for _, r := range t {
+		switch {
+		case r == rune(t[0]):
+			count++
+		case r != ' ':
+			return false
+		}
+	}
+	return count >= 3
+}
+
+// listMarker returns "ul" or "ol" when t starts a list item.
+func listMarker(t string) string {
+	if len(t) >= 2 && strings.ContainsRune("-*+", rune(t[0])) && t[1] == ' ' {
+		return "ul"
+	}
+	digits := 0
+	for digits < len(t) && digits < 9 && t[digits] >= '0' && t[digits] <= '9' {
+		digits++
+	}
+	if digits > 0 && digits+1 < len(t) && (t[digits] == '.' || t[digits] == ')') && t[digits+1] == ' ' {
+		return "ol"
+	}
+	return ""
+}
+
+func stripListMarker(t string) string {
+	if listMarker(t) == "ul" {
+		return t[2:]
+	}
+	return strings.TrimLeft(t[strings.IndexAny(t, ".)")+1:], " ")
+}
+
+func renderList(b *strings.Builder, lines []string, i int) int {
+	kind := listMarker(strings.TrimSpace(lines[i]))
+	indent := len(lines[i]) - len(strings.TrimLeft(lines[i], " "))
+
+	var items [][]string
+items:
+	for i < len(lines) {
+		line := lines[i]
+		t := strings.TrimSpace(line)
+		lineIndent := len(line) - len(strings.TrimLeft(line, " "))
+
+		switch {
+		case t == "":
+			// A blank line only continues the list when the next item or an
+			// indented continuation follows.
+			next := i + 1
+			for next < len(lines) && strings.TrimSpace(lines[next]) == "" {
+				next++
+			}
+			if next == len(lines) {
+				i = next
+				break items
+			}
+			nt := strings.TrimSpace(lines[next])
+			nIndent := len(lines[next]) - len(strings.TrimLeft(lines[next], " "))
+			if nIndent > indent || (nIndent == indent && listMarker(nt) == kind) {
+				items[len(items)-1] = append(items[len(items)-1], "")
+				i = next
+				continue
+			}
+			break items
+		case lineIndent <= indent && listMarker(t) == kind:
+			items = append(items, []string{stripListMarker(t)})
+		case lineIndent > indent:
+			items[len(items)-1] = append(items[len(items)-1], strings.TrimPrefix(line, strings.Repeat(" ", min(lineIndent, indent+4))))
+		case !startsBlock(line):
+			items[len(items)-1] = append(items[len(items)-1], t)
+		default:
+			break items
+		}
+		i++
+	}
+
+	b.WriteString("<" + kind + ">\n")
+	for _, item := range items {
+		for len(item) > 1 && strings.TrimSpace(item[len(item)-1]) == "" {
+			item = item[:len(item)-1]
+		}
+		b.WriteString("<li>")
+		n := 1
+		for n < len(item) && !startsBlock(item[n]) {
+			n++
+		}
+		renderParagraph(b, item[:n])
+		if n < len(item) {
+			b.WriteString("\n")
+			renderBlocks(b, item[n:])
+		}
+		b.WriteString("</li>\n")
+	}
+	b.WriteString("</" + kind + ">\n")
+	return i
+}
+
+// renderParagraph renders the lines of one paragraph. A line ending in two
+// spaces or a backslash forces a line break.
+func renderParagraph(b *strings.Builder, lines []string) {
+	for n, line := range lines {
+		line = strings.TrimLeft(line, " ")
+		hardBreak := n < len(lines)-1 && (strings.HasSuffix(line, "  ") || strings.HasSuffix(line, "\\"))
+		line = strings.TrimRight(strings.TrimSuffix(strings.TrimRight(line, " "), "\\"), " ")
+
+		renderInline(b, line)
+		switch {
+		case n == len(lines)-1:
+		case hardBreak:
+			b.WriteString("<br>\n")
+		default:
+			b.WriteString("\n")
+		}
+	}
+}
+
+// maxInlineDepth bounds how deeply links and emphasis nest. Anything nested
+// deeper is rendered as text.
+const maxInlineDepth = 16
+
+func renderInline(b *strings.Builder, s string) {
+	renderSpan(b, s, 0)
+}
+
+// span is one run of inline text being rendered. It remembers the closing
+// delimiters it has found or failed to find, so openers do not each scan the
+// rest of the text again and rendering stays linear in its length.
+type span struct {
+	s     string
+	depth int
+
+	// brackets[i] is the index of the ] closing the [ at i, or -1. It is
+	// filled in when the first [ is seen.
+	brackets []int
+	// unclosed holds the emphasis delimiters and code span fences that have
+	// no closer left in the rest of the text.
+	unclosed map[string]bool
+}
+
+func renderSpan(b *strings.Builder, s string, depth int) {
+	if depth >= maxInlineDepth {
+		b.WriteString(html.EscapeString(s))
+		return
+	}
+
+	sp := &span{s: s, depth: depth, unclosed: make(map[string]bool)}
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte(punctuation, s[i+1]) >= 0:
+			b.WriteString(html.EscapeString(s[i+1 : i+2]))
+			i += 2
+			continue
+
+		case c == '`':
+			if n := sp.renderCode(b, i); n > 0 {
+				i += n
+				continue
+			}
+
+		case c == '!' && strings.HasPrefix(s[i+1:], "["):
+			if text, target, n := sp.parseLink(i + 1); n > 0 {
+				if safeURL(target, imageSchemes) {
+					b.WriteString(`<img src="` + html.EscapeString(target) + `" alt="` + html.EscapeString(text) + `">`)
+				} else {
+					b.WriteString(html.EscapeString(text))
+				}
+				i += 1 + n
+				continue
+			}
+
+		case c == '[':
+			if text, target, n := sp.parseLink(i); n > 0 {
+				if safeURL(target, linkSchemes) {
+					b.WriteString(`<a href="` + html.EscapeString(target) + `" rel="nofollow noopener">`)
+					renderSpan(b, text, depth+1)
+					b.WriteString("</a>")
+				} else {
+					renderSpan(b, text, depth+1)
+				}
+				i += n
+				continue
+			}
+
+		case c == '<':
+			if end := strings.IndexByte(s[i:], '>'); end > 0 {
+				target := s[i+1 : i+end]
+				if !strings.ContainsAny(target, " <") && strings.Contains(target, ":") && safeURL(target, linkSchemes) {
+					b.WriteString(`<a href="` + html.EscapeString(target) + `" rel="nofollow noopener">` + html.EscapeString(target) + "</a>")
+					i += end + 1
+					continue
+				}
+			}
+
+		case c == '*' || c == '_' || (c == '~' && strings.HasPrefix(s[i:], "~~")):
+			if n := sp.renderEmphasis(b, i); n > 0 {
+				i += n
+				continue
+			}
+		}
+
+		b.WriteString(html.EscapeString(s[i : i+1]))
+		i++
+	}
+}
+
+const punctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+// renderCode renders a code span starting at s[i] and returns its length, or
+// zero when the backticks are not closed.
+func (sp *span) renderCode(b *strings.Builder, i int) int {
+	s := sp.s[i:]
+	ticks := len(s) - len(strings.TrimLeft(s, "`"))
+	fence := s[:ticks]
+	if sp.unclosed[fence] {
+		return 0
+	}
+
+	end := strings.Index(s[ticks:], fence)
+	for end >= 0 && ticks+end+ticks < len(s) && s[ticks+end+ticks] == '`' {
+		next := strings.Index(s[ticks+end+ticks:], fence)
+		if next < 0 {
+			end = -1
+			break
+		}
+		end += ticks + next
+	}
+	if end < 0 {
+		sp.unclosed[fence] = true
+		return 0
+	}
+
+	code := s[ticks : ticks+end]
+	if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' {
+		code = code[1 : len(code)-1]
+	}
+	b.WriteString("<code>" + html.EscapeString(code) + "</code>")
+	return ticks + end + ticks
+}
+
+// matchBrackets pairs every [ in s with its closing ], skipping escaped
+// characters.
+func matchBrackets(s string) []int {
+	brackets := make([]int, len(s))
+	var open []int
+	for i := 0; i < len(s); i++ {
+		brackets[i] = -1
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				brackets[i] = -1
+			}
+		case '[':
+			open = append(open, i)
+		case ']':
+			if len(open) > 0 {
+				brackets[open[len(open)-1]] = i
+				open = open[:len(open)-1]
+			}
+		}
+	}
+	return brackets
+}
+
+// maxLinkTail bounds the length of a link's (target "title") part.
+const maxLinkTail = 2048
+
+// parseLink parses [text](target) starting at s[i] and returns its parts and
+// length, or a zero length when no link starts there.
+func (sp *span) parseLink(i int) (string, string, int) {
+	if sp.brackets == nil {
+		sp.brackets = matchBrackets(sp.s)
+	}
+	s := sp.s[i:]
+	closeText := sp.brackets[i] - i
+	if closeText < 0 || closeText+1 >= len(s) || s[closeText+1] != '(' {
+		return "", "", 0
+	}
+
+	rest := s[closeText+2:]
+	if len(rest) > maxLinkTail {
+		rest = rest[:maxLinkTail]
+	}
+	depth := 0
+	end := 0
+	for ; end < len(rest); end++ {
+		if rest[end] == '(' {
+			depth++
+		} else if rest[end] == ')' && depth > 0 {
+			depth--
+		} else if rest[end] == ')' || rest[end] == ' ' {
+			break
+		}
+	}
+	target := strings.TrimSuffix(strings.TrimPrefix(rest[:end], "<"), ">")
+
+	// An optional "title" may follow the target; it is not rendered.
+	tail := strings.TrimLeft(rest[end:], " ")
+	if strings.HasPrefix(tail, `"`) {
+		closeTitle := strings.IndexByte(tail[1:], '"')
+		if closeTitle < 0 {
+			return "", "", 0
+		}
+		tail = strings.TrimLeft(tail[closeTitle+2:], " ")
+	}
+	if !strings.HasPrefix(tail, ")") {
+		return "", "", 0
+	}
+	end = len(rest) - len(tail)
+
+	return s[1:closeText], target, closeText + 2 + end + 1
+}
+
+var (
+	linkSchemes  = map[string]bool{"": true, "http": true, "https": true, "mailto": true}
+	imageSchemes = map[string]bool{"http": true, "https": true}
+)
+
+func safeURL(target string, schemes map[string]bool) bool {
+	if target == "" {
+		return false
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+	return schemes[strings.ToLower(u.Scheme)]
+}
+
+// renderEmphasis renders *em*, **strong**, _em_, __strong__ or ~~del~~ that
+// starts at s[i] and returns its length, or zero when it is not closed. A
+// delimiter without a closer has none for any later opener either, so it is
+// not searched for again.
+func (sp *span) renderEmphasis(b *strings.Builder, i int) int {
+	s := sp.s
+	c := s[i]
+	width := 1
+	if i+1 < len(s) && s[i+1] == c {
+		width = 2
+	}
+	if c == '~' && width != 2 {
+		return 0
+	}
+	// Intraword underscores, as in snake_case names, are literal.
+	if c == '_' && i > 0 && isWordByte(s[i-1]) {
+		return 0
+	}
+
+	start := i + width
+	if start >= len(s) || s[start] == ' ' {
+		return 0
+	}
+	delim := s[i:start]
+	if sp.unclosed[delim] {
+		return 0
+	}
+
+	for j := start + 1; j+width <= len(s); j++ {
+		if s[j] == '\\' {
+			j++
+			continue
+		}
+		if s[j] == '`' {
+			if end := strings.IndexByte(s[j+1:], '`'); end >= 0 {
+				j += end + 1
+			}
+			continue
+		}
+		if s[j] != c {
+			continue
+		}
+		run := 1
+		for j+run < len(s) && s[j+run] == c {
+			run++
+		}
+		if run < width || (width == 1 && run == 2) || s[j-1] == ' ' {
+			j += run - 1
+			continue
+		}
+		if c == '_' && j+width < len(s) && isWordByte(s[j+width]) {
+			j += run - 1
+			continue
+		}
+
+		tag := "em"
+		switch {
+		case c == '~':
+			tag = "del"
+		case width == 2:
+			tag = "strong"
+		}
+		b.WriteString("<" + tag + ">")
+		renderSpan(b, s[start:j], sp.depth+1)
+		b.WriteString("</" + tag + ">")
+		return j + width - i
+	}
+
+	sp.unclosed[delim] = true
+	return 0
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+// Text converts Markdown source to plain text. Formatting is dropped, links
+// and images are reduced to their text and every block or line break starts
+// a new line.
+func Text(src string) string {
+	return TextFromHTML(Render(src))
+}
+
+// TextFromHTML converts the output of Render or RenderPlain to plain text the
+// way Text does, for callers that already rendered the source.
+func TextFromHTML(rendered string) string {
+	var b strings.Builder
+	for len(rendered) > 0 {
+		open := strings.IndexByte(rendered, '<')
+		if open < 0 {
+			b.WriteString(rendered)
+			break
+		}
+		b.WriteString(rendered[:open])
+		end := strings.IndexByte(rendered[open:], '>')
+		tag := rendered[open : open+end+1]
+		rendered = rendered[open+end+1:]
+
+		if strings.HasPrefix(tag, "<img ") {
+			if alt := strings.Index(tag, `alt="`); alt >= 0 {
+				b.WriteString(tag[alt+5 : alt+5+strings.IndexByte(tag[alt+5:], '"')])
+			}
+		}
+		if breaksLine(tag) {
+			b.WriteString("\n")
+		}
+	}
+
+	var lines []string
+	for _, line := range strings.Split(html.UnescapeString(b.String()), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func breaksLine(tag string) bool {
+	switch tag {
+	case "<br>", "<hr>", "</p>", "</li>", "</pre>", "</blockquote>":
+		return true
+	}
+	return len(tag) == 5 && strings.HasPrefix(tag, "</h")
+}
@@ -0,0 +1,137 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	cases := []struct {
+		name, src, want string
+	}{
+		{"paragraphs", "Day one.\nStill day one.\n\nDay two.",
+			"<p>Day one.\nStill day one.</p>\n<p>Day two.</p>\n"},
+		{"heading", "## Lisbon ##", "<h2>Lisbon</h2>\n"},
+		{"not a heading", "#hashtag", "<p>#hashtag</p>\n"},
+		{"emphasis", "**bold**, *em*, _em_ and ~~gone~~",
+			"<p><strong>bold</strong>, <em>em</em>, <em>em</em> and <del>gone</del></p>\n"},
+		{"nested emphasis", "*a **b** c*", "<p><em>a <strong>b</strong> c</em></p>\n"},
+		{"snake case", "use snake_case_names", "<p>use snake_case_names</p>\n"},
+		{"unclosed", "2 * 3 = 6", "<p>2 * 3 = 6</p>\n"},
+		{"code span", "run `a < b`", "<p>run <code>a &lt; b</code></p>\n"},
+		{"escape", `\*not em\*`, "<p>*not em*</p>\n"},
+		{"hard break", "line one  \nline two", "<p>line one<br>\nline two</p>\n"},
+		{"link", "[map](https://example.com/a?b=1&c=2)",
+			`<p><a href="https://example.com/a?b=1&amp;c=2" rel="nofollow noopener">map</a></p>` + "\n"},
+		{"link with title", `[Rome](https://en.wikipedia.org/wiki/Rome_(city) "Rome")`,
+			`<p><a href="https://en.wikipedia.org/wiki/Rome_(city)" rel="nofollow noopener">Rome</a></p>` + "\n"},
+		{"autolink", "<https://example.com>",
+			`<p><a href="https://example.com" rel="nofollow noopener">https://example.com</a></p>` + "\n"},
+		{"image", "![Tram 28](https://cdn.example.com/tram.jpg)",
+			`<p><img src="https://cdn.example.com/tram.jpg" alt="Tram 28"></p>` + "\n"},
+		{"rule", "a\n\n---\n\nb", "<p>a</p>\n<hr>\n<p>b</p>\n"},
+		{"quote", "> quoted\n> *text*", "<blockquote>\n<p>quoted\n<em>text</em></p>\n</blockquote>\n"},
+		{"unordered list", "- one\n- two\n  more\n* other",
+			"<ul>\n<li>one</li>\n<li>two\nmore</li>\n<li>other</li>\n</ul>\n"},
+		{"ordered list", "1. pack\n2. fly", "<ol>\n<li>pack</li>\n<li>fly</li>\n</ol>\n"},
+		{"nested list", "- a\n  - b\n- c", "<ul>\n<li>a\n<ul>\n<li>b</li>\n</ul>\n</li>\n<li>c</li>\n</ul>\n"},
+		{"fence", "```go\nif a < b {\n}\n```", "<pre><code>if a &lt; b {\n}\n</code></pre>\n"},
+	}
+
+	for _, c := range cases {
+		if got := Render(c.src); got != c.want {
+			t.Errorf("%s:\n got %q\nwant %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRenderSanitizes(t *testing.T) {
+	cases := []string{
+		`<script>alert(1)</script>`,
+		`<img src=x onerror=alert(1)>`,
+		`[click](javascript:alert(1))`,
+		`[click](JavaScript:alert(1))`,
+		`[click](java	script:alert(1))`,
+		`![x](data:image/svg+xml;base64,PHN2Zz4=)`,
+		`<javascript:alert(1)>`,
+		`[x](http://a.com/"onmouseover="alert(1))`,
+		"```\n</code></pre><script>x</script>\n```",
+	}
+
+	for _, src := range cases {
+		got := Render(src)
+		for _, tag := range tagPattern.FindAllString(got, -1) {
+			if !allowedTag.MatchString(tag) {
+				t.Errorf("Render(%q) produced %s", src, tag)
+			}
+		}
+		if strings.Contains(got, `="javascript`) || strings.Contains(got, `="data`) {
+			t.Errorf("Render(%q) = %q", src, got)
+		}
+	}
+}
+
+var (
+	tagPattern = regexp.MustCompile(`<[^>]*>`)
+	allowedTag = regexp.MustCompile(`^</?(p|h[1-6]|blockquote|ul|ol|li|pre|code|em|strong|del|hr|br|a|img)( (href|src|alt|rel)="[^"]*")*>$`)
+)
+
+func TestRenderPlain(t *testing.T) {
+	got := RenderPlain("Tom & Jerry\n<b>went</b>\n\n\nhome")
+	want := "<p>Tom &amp; Jerry<br>\n&lt;b&gt;went&lt;/b&gt;</p>\n<p>home</p>\n"
+	if got != want {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+}
+
+func TestText(t *testing.T) {
+	cases := []struct {
+		name, src, want string
+	}{
+		{"formatting", "## Day *one*\n\n**Lisbon** & ~~Porto~~", "Day one\nLisbon & Porto"},
+		{"links", "See [the map](https://example.com) or <https://example.com/b>", "See the map or https://example.com/b"},
+		{"image", "![Tram 28](https://cdn.example.com/tram.jpg)", "Tram 28"},
+		{"lists", "- pack\n- fly\n\n1. eat", "pack\nfly\neat"},
+		{"quote and code", "> `a < b`\n\n```\nx := 1\n```", "a < b\nx := 1"},
+		{"unsafe link", "[click](javascript:alert(1))", "click"},
+	}
+
+	for _, c := range cases {
+		if got := Text(c.src); got != c.want {
+			t.Errorf("%s:\n got %q\nwant %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestTextFromHTML(t *testing.T) {
+	got := TextFromHTML(RenderPlain("Tom & Jerry\n<b>went</b>\n\n\nhome"))
+	want := "Tom & Jerry\n<b>went</b>\nhome"
+	if got != want {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+}
+
+func TestRenderPathological(t *testing.T) {
+	const n = 100_000
+	cases := map[string]string{
+		"brackets":      strings.Repeat("[", n),
+		"links":         strings.Repeat("[a](", n/4),
+		"emphasis":      strings.Repeat("*a ", n/3),
+		"underscores":   strings.Repeat("_a ", n/3),
+		"strikethrough": strings.Repeat("~~a ", n/4),
+		"code":          strings.Repeat("`a ``b ", n/7),
+		"nesting":       strings.Repeat("*[", n/2),
+		"quotes":        strings.Repeat(">", n),
+		"lists":         strings.Repeat("- ", n/2),
+	}
+
+	for name, src := range cases {
+		start := time.Now()
+		Render(src)
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("%s: rendering %d bytes took %v", name, len(src), elapsed)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package media
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+)
+
+var extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// MaxPixels caps the dimensions of uploaded images. Decoding allocates
+// memory for every pixel, so a small file declaring huge dimensions must be
+// turned away before it is decoded.
+const MaxPixels = 40_000_000
+
+// Info describes an uploaded file. The content type is sniffed from the data
+// itself, never taken from the client.
+type Info struct {
+	ContentType string
+	Ext         string
+	Size        int64
+	Width       int
+	Height      int
+	Checksum    string
+}
+
+// Inspect validates that data is a supported image of at most MaxPixels
+// pixels and reads its metadata. Only the image header is decoded.
+func Inspect(data []byte) (Info, error) {
+	if len(data) == 0 {
+		return Info{}, fmt.Errorf("empty upload")
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := extensions[contentType]
+	if !ok {
+		return Info{}, fmt.Errorf("unsupported media type: %s", contentType)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Info{}, fmt.Errorf("invalid %s: %v", contentType, err)
+	}
+	if int64(config.Width)*int64(config.Height) > MaxPixels {
+		return Info{}, fmt.Errorf("image of %dx%d pixels is larger than %d pixels", config.Width, config.Height, MaxPixels)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return Info{
+		ContentType: contentType,
+		Ext:         ext,
+		Size:        int64(len(data)),
+		Width:       config.Width,
+		Height:      config.Height,
+		Checksum:    hex.EncodeToString(sum[:]),
+	}, nil
+}
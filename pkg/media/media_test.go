@@ -0,0 +1,69 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 40, 30))); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := Inspect(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ContentType != "image/png" || info.Ext != ".png" {
+		t.Errorf("got type %q ext %q", info.ContentType, info.Ext)
+	}
+	if info.Width != 40 || info.Height != 30 {
+		t.Errorf("got %dx%d, want 40x30", info.Width, info.Height)
+	}
+	if info.Size != int64(buf.Len()) || len(info.Checksum) != 64 {
+		t.Errorf("got size %d checksum %q", info.Size, info.Checksum)
+	}
+}
+
+func TestInspectRejects(t *testing.T) {
+	cases := map[string][]byte{
+		"empty":     nil,
+		"text":      []byte("just some text"),
+		"truncated": []byte("\x89PNG\r\n\x1a\n\x00\x00"),
+	}
+	for name, data := range cases {
+		if _, err := Inspect(data); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+// hugePNG returns a tiny PNG whose header claims the given dimensions.
+func hugePNG(t *testing.T, width, height uint32) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	// The IHDR chunk follows the 8 byte signature: length, type, data, crc.
+	ihdr := data[16:29]
+	binary.BigEndian.PutUint32(ihdr[0:], width)
+	binary.BigEndian.PutUint32(ihdr[4:], height)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+	return data
+}
+
+func TestInspectRejectsTooManyPixels(t *testing.T) {
+	if _, err := Inspect(hugePNG(t, 5000, 5000)); err != nil {
+		t.Errorf("5000x5000: %v", err)
+	}
+	if _, err := Inspect(hugePNG(t, 50000, 50000)); err == nil {
+		t.Error("50000x50000 image was accepted")
+	}
+}
@@ -0,0 +1,94 @@
+package mention
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxUsernameLength matches users.username.
+const MaxUsernameLength = 50
+
+// MaxMentions caps how many users one text can mention; the rest are
+// ignored.
+const MaxMentions = 20
+
+// Parse returns the lowercased usernames mentioned as "@username" in text, in
+// order of first appearance and without duplicates. An "@" only starts a
+// mention at the start of a word, so e-mail addresses are skipped, and code
+// spans and fenced code blocks are ignored. A username is made of letters,
+// digits, "_" and inner "."; a trailing "." ends the sentence, not the name.
+func Parse(text string) []string {
+	var names []string
+	seen := make(map[string]bool)
+
+	inFence := false
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		for _, name := range parseLine(line) {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+			if len(names) == MaxMentions {
+				return names
+			}
+		}
+	}
+
+	return names
+}
+
+func parseLine(line string) []string {
+	var names []string
+	inCode := false
+	prev := ' '
+
+	for i := 0; i < len(line); {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		switch {
+		case r == '`':
+			inCode = !inCode
+		case r == '@' && !inCode && !isNameRune(prev) && prev != '@':
+			if name := scanName(line[i+size:]); name != "" {
+				names = append(names, strings.ToLower(name))
+				i += size + len(name)
+				prev = 'a'
+				continue
+			}
+		}
+		prev = r
+		i += size
+	}
+
+	return names
+}
+
+func scanName(s string) string {
+	end := 0
+	for end < len(s) {
+		r, size := utf8.DecodeRuneInString(s[end:])
+		if !isNameRune(r) && r != '.' {
+			break
+		}
+		end += size
+	}
+
+	name := strings.TrimRight(s[:end], ".")
+	if name == "" || len(name) > MaxUsernameLength {
+		return ""
+	}
+	return name
+}
+
+func isNameRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,43 @@
+package mention
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", nil},
+		{"no mentions here", nil},
+		{"@alice", []string{"alice"}},
+		{"Thanks @Alice and @bob_99!", []string{"alice", "bob_99"}},
+		{"(@alice) @alice @ALICE", []string{"alice"}},
+		{"Ask @john.doe.", []string{"john.doe"}},
+		{"mail me at someone@example.com", nil},
+		{"@@alice and @ alone", nil},
+		{"skip `@code` but not @real", []string{"real"}},
+		{"```\n@fenced\n```\n@after", []string{"after"}},
+		{"@" + strings.Repeat("a", MaxUsernameLength+1), nil},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Parse(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	var b strings.Builder
+	for i := 0; i < MaxMentions+5; i++ {
+		b.WriteString("@user")
+		b.WriteByte(byte('a' + i))
+		b.WriteByte(' ')
+	}
+	if got := Parse(b.String()); len(got) != MaxMentions {
+		t.Errorf("Parse returned %d mentions, want %d", len(got), MaxMentions)
+	}
+}
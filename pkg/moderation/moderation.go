@@ -0,0 +1,294 @@
+package moderation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Action is what happens to a piece of content after moderation.
+type Action string
+
+const (
+	Allow  Action = "allow"
+	Hold   Action = "hold"
+	Reject Action = "reject"
+)
+
+// Content is the text submitted by a user. Kind names what is being created
+// ("story", "story_comment", ...) and lets filters treat kinds differently.
+type Content struct {
+	AuthorID string
+	Kind     string
+	Text     string
+}
+
+// Result is a single filter's opinion. A filter adds to the score of
+// content it finds suspicious, or rejects it outright.
+type Result struct {
+	Score  float64
+	Reject bool
+	Reason string
+}
+
+// Filter inspects content. Filters must be safe for concurrent use.
+type Filter interface {
+	Check(ctx context.Context, c Content) (Result, error)
+}
+
+// Recorder is implemented by filters that remember content. Check does not
+// record anything; Record is called once the content was stored, so
+// rejected or failed submissions leave no trace.
+type Recorder interface {
+	Record(ctx context.Context, c Content) error
+}
+
+// Verdict is the outcome of running a pipeline.
+type Verdict struct {
+	Action  Action
+	Score   float64
+	Reasons []string
+}
+
+// Pipeline runs its filters in order and adds up their scores. Content is
+// rejected when a filter rejects it or the score reaches RejectScore, held
+// for review when the score reaches HoldScore and allowed otherwise. A zero
+// threshold is not applied.
+//
+// A filter that fails fails the run, unless OnError is set: then the error
+// is passed to OnError and the run goes on without that filter.
+type Pipeline struct {
+	Filters     []Filter
+	HoldScore   float64
+	RejectScore float64
+	OnError     func(err error)
+}
+
+func (p *Pipeline) Run(ctx context.Context, c Content) (Verdict, error) {
+	var v Verdict
+	reject := false
+
+	for _, f := range p.Filters {
+		res, err := f.Check(ctx, c)
+		if err != nil && p.OnError != nil {
+			p.OnError(fmt.Errorf("moderation filter %T: %v", f, err))
+			continue
+		}
+		if err != nil {
+			return Verdict{}, err
+		}
+		if res.Score <= 0 && !res.Reject {
+			continue
+		}
+		v.Score += res.Score
+		reject = reject || res.Reject
+		if res.Reason != "" {
+			v.Reasons = append(v.Reasons, res.Reason)
+		}
+	}
+
+	switch {
+	case reject, p.RejectScore > 0 && v.Score >= p.RejectScore:
+		v.Action = Reject
+	case p.HoldScore > 0 && v.Score >= p.HoldScore:
+		v.Action = Hold
+	default:
+		v.Action = Allow
+	}
+
+	return v, nil
+}
+
+// Record lets the filters that remember content record c.
+func (p *Pipeline) Record(ctx context.Context, c Content) error {
+	for _, f := range p.Filters {
+		if r, ok := f.(Recorder); ok {
+			if err := r.Record(ctx, c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WordList matches whole words and phrases case-insensitively. With Reject
+// set any match rejects the content; otherwise every distinct match adds
+// Score.
+type WordList struct {
+	Words  []string
+	Score  float64
+	Reject bool
+}
+
+func (w *WordList) Check(ctx context.Context, c Content) (Result, error) {
+	text := " " + strings.Join(words(c.Text), " ") + " "
+
+	var matched []string
+	for _, word := range w.Words {
+		phrase := strings.Join(words(word), " ")
+		if phrase != "" && strings.Contains(text, " "+phrase+" ") {
+			matched = append(matched, phrase)
+		}
+	}
+	if len(matched) == 0 {
+		return Result{}, nil
+	}
+
+	reason := fmt.Sprintf("contains %s", strings.Join(quoteAll(matched), ", "))
+	if w.Reject {
+		return Result{Reject: true, Reason: reason}, nil
+	}
+	return Result{Score: w.Score * float64(len(matched)), Reason: reason}, nil
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// CountLinks returns the number of http(s) and www. links in text.
+func CountLinks(text string) int {
+	return len(linkPattern.FindAllStringIndex(text, -1))
+}
+
+// LinkCount scores content with more than Max links; every extra link adds
+// Score.
+type LinkCount struct {
+	Max   int
+	Score float64
+}
+
+func (l *LinkCount) Check(ctx context.Context, c Content) (Result, error) {
+	n := CountLinks(c.Text)
+	if n <= l.Max {
+		return Result{}, nil
+	}
+	return Result{
+		Score:  l.Score * float64(n-l.Max),
+		Reason: fmt.Sprintf("%d links, at most %d allowed", n, l.Max),
+	}, nil
+}
+
+// Repetition scores text that repeats itself: a single word making up more
+// than MaxWordShare of a text of at least MinWords words, or a letter or
+// digit repeated more than MaxRun times in a row. Runs of punctuation are
+// left alone: Markdown rules and table separators are made of them.
+type Repetition struct {
+	MinWords     int
+	MaxWordShare float64
+	MaxRun       int
+	Score        float64
+}
+
+func (r *Repetition) Check(ctx context.Context, c Content) (Result, error) {
+	if r.MaxRun > 0 {
+		if run := longestRun(c.Text); run > r.MaxRun {
+			return Result{Score: r.Score, Reason: fmt.Sprintf("letter or digit repeated %d times", run)}, nil
+		}
+	}
+
+	ws := words(c.Text)
+	if r.MaxWordShare <= 0 || len(ws) < r.MinWords || len(ws) == 0 {
+		return Result{}, nil
+	}
+
+	counts := make(map[string]int)
+	top, topCount := "", 0
+	for _, w := range ws {
+		counts[w]++
+		if counts[w] > topCount {
+			top, topCount = w, counts[w]
+		}
+	}
+
+	share := float64(topCount) / float64(len(ws))
+	if share <= r.MaxWordShare {
+		return Result{}, nil
+	}
+	return Result{Score: r.Score, Reason: fmt.Sprintf("%q makes up %.0f%% of the text", top, share*100)}, nil
+}
+
+func longestRun(text string) int {
+	longest, run := 0, 0
+	var prev rune = -1
+	for _, r := range text {
+		if r == prev && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			run++
+		} else {
+			run = 1
+		}
+		prev = r
+		if run > longest {
+			longest = run
+		}
+	}
+	return longest
+}
+
+// SeenStore remembers content fingerprints for a while.
+type SeenStore interface {
+	// Seen reports whether key is recorded.
+	Seen(ctx context.Context, key string) (bool, error)
+	// Remember records key for ttl. Recording it again does not extend the
+	// original ttl.
+	Remember(ctx context.Context, key string, ttl time.Duration) error
+}
+
+// Duplicate scores content its author already posted within Window. Texts
+// shorter than MinLength characters are skipped so short replies such as
+// "thanks!" can be repeated.
+type Duplicate struct {
+	Store     SeenStore
+	Window    time.Duration
+	MinLength int
+	Score     float64
+}
+
+func (d *Duplicate) Check(ctx context.Context, c Content) (Result, error) {
+	normalized := strings.Join(words(c.Text), " ")
+	if len([]rune(normalized)) < d.MinLength {
+		return Result{}, nil
+	}
+
+	seen, err := d.Store.Seen(ctx, Fingerprint(c.AuthorID, normalized))
+	if err != nil {
+		return Result{}, err
+	}
+	if !seen {
+		return Result{}, nil
+	}
+	return Result{Score: d.Score, Reason: "duplicate of recent content"}, nil
+}
+
+// Record remembers the fingerprint of stored content for Window.
+func (d *Duplicate) Record(ctx context.Context, c Content) error {
+	normalized := strings.Join(words(c.Text), " ")
+	if len([]rune(normalized)) < d.MinLength {
+		return nil
+	}
+	return d.Store.Remember(ctx, Fingerprint(c.AuthorID, normalized), d.Window)
+}
+
+// Fingerprint identifies a text by its author, ignoring case, punctuation
+// and spacing.
+func Fingerprint(authorID, text string) string {
+	sum := sha256.Sum256([]byte(authorID + "\x00" + strings.Join(words(text), " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// words splits text into lowercased words of letters and digits.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func quoteAll(ss []string) []string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return quoted
+}
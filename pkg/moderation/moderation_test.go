@@ -0,0 +1,179 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWordList(t *testing.T) {
+	ctx := context.Background()
+	flagged := &WordList{Words: []string{"casino", "free money"}, Score: 1}
+
+	tests := []struct {
+		text  string
+		score float64
+	}{
+		{"Lovely beach", 0},
+		{"Best CASINO in town", 1},
+		{"casinos are not matched", 0},
+		{"Free   money at the casino!", 2},
+	}
+	for _, tt := range tests {
+		res, err := flagged.Check(ctx, Content{Text: tt.text})
+		if err != nil || res.Score != tt.score || res.Reject {
+			t.Errorf("Check(%q) = %+v, %v, want score %v", tt.text, res, err, tt.score)
+		}
+	}
+
+	blocked := &WordList{Words: []string{"slur"}, Reject: true}
+	if res, _ := blocked.Check(ctx, Content{Text: "a Slur."}); !res.Reject {
+		t.Error("blocked word was not rejected")
+	}
+}
+
+func TestLinkCount(t *testing.T) {
+	ctx := context.Background()
+	l := &LinkCount{Max: 1, Score: 1}
+
+	if n := CountLinks("see https://a.com, www.b.org and http://c.net/x?y"); n != 3 {
+		t.Errorf("CountLinks = %d, want 3", n)
+	}
+	if res, _ := l.Check(ctx, Content{Text: "one https://a.com link"}); res.Score != 0 {
+		t.Errorf("one link scored %v", res.Score)
+	}
+	if res, _ := l.Check(ctx, Content{Text: "https://a.com https://b.com https://c.com"}); res.Score != 2 {
+		t.Errorf("three links scored %v, want 2", res.Score)
+	}
+}
+
+func TestRepetition(t *testing.T) {
+	ctx := context.Background()
+	r := &Repetition{MinWords: 5, MaxWordShare: 0.5, MaxRun: 8, Score: 1}
+
+	tests := []struct {
+		text  string
+		score float64
+	}{
+		{"We walked along the river and then up the hill", 0},
+		{"buy buy buy buy now", 1},
+		{"buy buy buy", 0},
+		{"Wooooooooooow", 1},
+		{"Wow!!!!!!!!!", 0},
+		{"line one\n\n\n\n\n\n\n\n\n\nline two", 0},
+		{"# Day one\n\n----------\n\n| Stop | Nights |\n|------------|------------|\n| Porto | 2 |", 0},
+	}
+	for _, tt := range tests {
+		if res, _ := r.Check(ctx, Content{Text: tt.text}); res.Score != tt.score {
+			t.Errorf("Check(%q) = %+v, want score %v", tt.text, res, tt.score)
+		}
+	}
+}
+
+type memoryStore map[string]bool
+
+func (m memoryStore) Seen(ctx context.Context, key string) (bool, error) {
+	return m[key], nil
+}
+
+func (m memoryStore) Remember(ctx context.Context, key string, ttl time.Duration) error {
+	m[key] = true
+	return nil
+}
+
+func TestDuplicate(t *testing.T) {
+	ctx := context.Background()
+	d := &Duplicate{Store: memoryStore{}, Window: time.Hour, MinLength: 10, Score: 2}
+
+	first := Content{AuthorID: "u1", Text: "Check out my page for cheap flights"}
+	again := Content{AuthorID: "u1", Text: "check out my page, for CHEAP flights!"}
+	other := Content{AuthorID: "u2", Text: first.Text}
+
+	if res, _ := d.Check(ctx, first); res.Score != 0 {
+		t.Errorf("first post scored %v", res.Score)
+	}
+	if res, _ := d.Check(ctx, again); res.Score != 0 {
+		t.Errorf("repost of unrecorded post scored %v", res.Score)
+	}
+	d.Record(ctx, first)
+	if res, _ := d.Check(ctx, again); res.Score != 2 {
+		t.Errorf("repost scored %v, want 2", res.Score)
+	}
+	if res, _ := d.Check(ctx, other); res.Score != 0 {
+		t.Errorf("another author's post scored %v", res.Score)
+	}
+	for i := 0; i < 2; i++ {
+		reply := Content{AuthorID: "u1", Text: "Thanks!"}
+		if res, _ := d.Check(ctx, reply); res.Score != 0 {
+			t.Errorf("short reply scored %v", res.Score)
+		}
+		d.Record(ctx, reply)
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+	p := &Pipeline{
+		Filters: []Filter{
+			&WordList{Words: []string{"slur"}, Reject: true},
+			&WordList{Words: []string{"casino", "crypto"}, Score: 1},
+			&LinkCount{Max: 1, Score: 1},
+		},
+		HoldScore:   1,
+		RejectScore: 3,
+	}
+
+	tests := []struct {
+		text    string
+		action  Action
+		reasons int
+	}{
+		{"A quiet morning in Lisbon", Allow, 0},
+		{"Visit the casino", Hold, 1},
+		{"casino crypto https://a.com https://b.com", Reject, 2},
+		{"what a slur", Reject, 1},
+	}
+	for _, tt := range tests {
+		v, err := p.Run(ctx, Content{Text: tt.text})
+		if err != nil || v.Action != tt.action || len(v.Reasons) != tt.reasons {
+			t.Errorf("Run(%q) = %+v, %v, want %s with %d reasons", tt.text, v, err, tt.action, tt.reasons)
+		}
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Seen(ctx context.Context, key string) (bool, error) {
+	return false, errors.New("store unavailable")
+}
+
+func (failingStore) Remember(ctx context.Context, key string, ttl time.Duration) error {
+	return errors.New("store unavailable")
+}
+
+func TestPipelineFilterError(t *testing.T) {
+	ctx := context.Background()
+	content := Content{AuthorID: "u1", Text: "Visit the casino for cheap flights"}
+	p := &Pipeline{
+		Filters: []Filter{
+			&Duplicate{Store: failingStore{}, Window: time.Hour, MinLength: 10, Score: 2},
+			&WordList{Words: []string{"casino"}, Score: 1},
+		},
+		HoldScore: 1,
+	}
+
+	if _, err := p.Run(ctx, content); err == nil {
+		t.Error("Run without OnError ignored a failing filter")
+	}
+
+	var reported []error
+	p.OnError = func(err error) { reported = append(reported, err) }
+	v, err := p.Run(ctx, content)
+	if err != nil || v.Action != Hold || len(v.Reasons) != 1 {
+		t.Errorf("Run = %+v, %v, want hold by the remaining filter", v, err)
+	}
+	if len(reported) != 1 {
+		t.Errorf("OnError was called %d times, want 1", len(reported))
+	}
+}
@@ -0,0 +1,64 @@
+// Package reading computes how long a text is to read and a short preview of
+// it. Texts are plain text; Markdown must be converted first.
+package reading
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Version identifies the computation. Bump it whenever Compute or Excerpt
+// change so stored values are recomputed.
+const Version = 1
+
+// WordsPerMinute is the assumed reading speed.
+const WordsPerMinute = 200
+
+type Stats struct {
+	Words   int
+	Minutes int
+}
+
+// Compute counts the words of text and estimates its reading time in whole
+// minutes, rounded up. Any text with words takes at least a minute.
+func Compute(text string) Stats {
+	words := 0
+	for _, field := range strings.Fields(text) {
+		if strings.IndexFunc(field, isWordRune) >= 0 {
+			words++
+		}
+	}
+	return Stats{Words: words, Minutes: (words + WordsPerMinute - 1) / WordsPerMinute}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Excerpt returns the start of text on a single line, at most max runes
+// long. Longer texts are cut at a word boundary where possible and end in
+// an ellipsis.
+func Excerpt(text string, max int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+
+	cut := []rune(text)[:max-1]
+	if space := lastSpace(cut); space > len(cut)/2 {
+		cut = cut[:space]
+	}
+	return strings.TrimRightFunc(string(cut), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
+
+func lastSpace(rs []rune) int {
+	for i := len(rs) - 1; i >= 0; i-- {
+		if rs[i] == ' ' {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,48 @@
+package reading
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestCompute(t *testing.T) {
+	cases := []struct {
+		text           string
+		words, minutes int
+	}{
+		{"", 0, 0},
+		{" - — ... ", 0, 0},
+		{"We took the 28 tram — twice!", 6, 1},
+		{strings.Repeat("word ", 200), 200, 1},
+		{strings.Repeat("word ", 201), 201, 2},
+		{"Überfahrt nach Åre, 東京", 4, 1},
+	}
+	for _, c := range cases {
+		if got := Compute(c.text); got.Words != c.words || got.Minutes != c.minutes {
+			t.Errorf("Compute(%q) = %+v, want %d words, %d minutes", c.text, got, c.words, c.minutes)
+		}
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	cases := []struct {
+		text string
+		max  int
+		want string
+	}{
+		{"Short\n\ntext", 20, "Short text"},
+		{"We walked along the river, then up the hill", 30, "We walked along the river…"},
+		{"Supercalifragilisticexpialidocious", 10, "Supercali…"},
+		{"Café crème, s'il vous plaît", 12, "Café crème…"},
+	}
+	for _, c := range cases {
+		got := Excerpt(c.text, c.max)
+		if got != c.want {
+			t.Errorf("Excerpt(%q, %d) = %q, want %q", c.text, c.max, got, c.want)
+		}
+		if utf8.RuneCountInString(got) > c.max {
+			t.Errorf("Excerpt(%q, %d) is %d runes long", c.text, c.max, utf8.RuneCountInString(got))
+		}
+	}
+}
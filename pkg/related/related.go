@@ -0,0 +1,79 @@
+// Package related scores how closely another story relates to the one being
+// read.
+package related
+
+import (
+	"math"
+	"sort"
+)
+
+// Signal weights. Tags describe what a story is about and shared likers
+// what its readers enjoy, so both count more than a shared place or author.
+const (
+	TagWeight      = 3.0
+	LikerWeight    = 3.0
+	LocationWeight = 2.0
+	AuthorWeight   = 1.0
+)
+
+// Signals is what a candidate story has in common with the source story.
+type Signals struct {
+	SharedTags    int64
+	Tags          int64 // tags of the source story
+	CandidateTags int64
+
+	SharedLikers   int64
+	Likes          int64 // likes of the source story
+	CandidateLikes int64
+
+	SameLocation bool
+	SameAuthor   bool
+}
+
+// Score combines the signals. Tag overlap is the Jaccard index of both tag
+// sets and liker overlap the cosine similarity of both sets of likers, so
+// stories with many tags or likes do not win by size alone.
+func Score(s Signals) float64 {
+	var score float64
+	if union := s.Tags + s.CandidateTags - s.SharedTags; s.SharedTags > 0 && union > 0 {
+		score += TagWeight * float64(s.SharedTags) / float64(union)
+	}
+	if s.SharedLikers > 0 && s.Likes > 0 && s.CandidateLikes > 0 {
+		score += LikerWeight * math.Min(1, float64(s.SharedLikers)/math.Sqrt(float64(s.Likes*s.CandidateLikes)))
+	}
+	if s.SameLocation {
+		score += LocationWeight
+	}
+	if s.SameAuthor {
+		score += AuthorWeight
+	}
+	return score
+}
+
+// Scored is a candidate story with its score.
+type Scored struct {
+	StoryId string  `json:"story_id"`
+	Score   float64 `json:"score"`
+}
+
+// Rank scores the candidates and returns the best max of them, highest
+// score first. Candidates without anything in common are dropped.
+func Rank(candidates map[string]Signals, max int) []Scored {
+	ranked := make([]Scored, 0, len(candidates))
+	for id, signals := range candidates {
+		if score := Score(signals); score > 0 {
+			ranked = append(ranked, Scored{StoryId: id, Score: score})
+		}
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].StoryId < ranked[j].StoryId
+	})
+	if len(ranked) > max {
+		ranked = ranked[:max]
+	}
+	return ranked
+}
@@ -0,0 +1,51 @@
+package related
+
+import (
+	"math"
+	"testing"
+)
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name string
+		s    Signals
+		want float64
+	}{
+		{"nothing in common", Signals{Tags: 3, CandidateTags: 2, Likes: 10, CandidateLikes: 4}, 0},
+		{"same tags", Signals{SharedTags: 2, Tags: 2, CandidateTags: 2}, TagWeight},
+		{"some tags", Signals{SharedTags: 1, Tags: 2, CandidateTags: 3}, TagWeight / 4},
+		{"shared likers", Signals{SharedLikers: 2, Likes: 4, CandidateLikes: 16}, LikerWeight / 4},
+		{"stale like counts", Signals{SharedLikers: 5, Likes: 2, CandidateLikes: 2}, LikerWeight},
+		{"place and author", Signals{SameLocation: true, SameAuthor: true}, LocationWeight + AuthorWeight},
+	}
+	for _, tt := range tests {
+		if got := Score(tt.s); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: Score = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	candidates := map[string]Signals{
+		"author":    {SameAuthor: true},
+		"tags":      {SharedTags: 2, Tags: 2, CandidateTags: 2},
+		"place":     {SameLocation: true},
+		"unrelated": {Tags: 2, CandidateTags: 1},
+		"place2":    {SameLocation: true},
+	}
+
+	got := Rank(candidates, 3)
+	want := []string{"tags", "place", "place2"}
+	if len(got) != len(want) {
+		t.Fatalf("Rank = %v, want %v", got, want)
+	}
+	for i, id := range want {
+		if got[i].StoryId != id {
+			t.Errorf("Rank[%d] = %s, want %s", i, got[i].StoryId, id)
+		}
+	}
+
+	if got := Rank(candidates, 10); len(got) != 4 {
+		t.Errorf("Rank kept %d candidates, want 4", len(got))
+	}
+}
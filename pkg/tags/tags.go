@@ -0,0 +1,55 @@
+package tags
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxLength matches the size of story_tags.tag.
+const MaxLength = 50
+
+// Normalize folds a tag to its stored form: trimmed, lower-cased and slugged so
+// that "#New York " and "new-york" are the same tag. It returns an empty
+// string if nothing usable is left.
+func Normalize(tag string) string {
+	tag = strings.TrimLeft(strings.TrimSpace(tag), "#")
+
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(tag) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			dash = false
+			b.WriteRune(r)
+			continue
+		}
+		dash = true
+	}
+
+	slug := b.String()
+	for len(slug) > MaxLength {
+		_, size := utf8.DecodeLastRuneInString(slug)
+		slug = slug[:len(slug)-size]
+	}
+
+	return strings.TrimRight(slug, "-")
+}
+
+// NormalizeAll normalizes every tag, dropping empty ones and duplicates while
+// keeping the original order.
+func NormalizeAll(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = Normalize(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+	return res
+}
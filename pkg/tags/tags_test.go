@@ -0,0 +1,36 @@
+package tags
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		"  Beach ":         "beach",
+		"#NewYork":         "newyork",
+		"New York":         "new-york",
+		"road__trip!!2024": "road-trip-2024",
+		"--":               "",
+		"Самарканд":        "самарканд",
+	}
+	for in, want := range cases {
+		if got := Normalize(in); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	long := strings.Repeat("a", 49) + " b"
+	if got := Normalize(long); got != strings.Repeat("a", 49) {
+		t.Errorf("Normalize(long) = %q, want trailing dash trimmed", got)
+	}
+}
+
+func TestNormalizeAll(t *testing.T) {
+	got := NormalizeAll([]string{"Beach", "beach ", "", "#Hiking", "!!"})
+	want := []string{"beach", "hiking"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeAll returned %v, want %v", got, want)
+	}
+}
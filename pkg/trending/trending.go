@@ -0,0 +1,74 @@
+package trending
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Window is a trending period. Each window only ranks stories published in
+// it and decays at its own pace, so the daily list turns over much faster
+// than the monthly one.
+type Window string
+
+const (
+	Day   Window = "day"
+	Week  Window = "week"
+	Month Window = "month"
+)
+
+// Windows lists every window, shortest first.
+var Windows = []Window{Day, Week, Month}
+
+// Engagement weights. A comment takes more effort than a like, a view
+// hardly any.
+const (
+	LikeWeight    = 1.0
+	CommentWeight = 2.0
+	ViewWeight    = 0.1
+)
+
+func ParseWindow(s string) (Window, error) {
+	switch w := Window(s); w {
+	case "":
+		return Day, nil
+	case Day, Week, Month:
+		return w, nil
+	}
+	return "", fmt.Errorf("unknown trending window %q", s)
+}
+
+// Span is how far back the window reaches.
+func (w Window) Span() time.Duration {
+	switch w {
+	case Week:
+		return 7 * 24 * time.Hour
+	case Month:
+		return 30 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// decay is the age after which a story needs ten times the engagement to
+// keep its rank against a new one.
+func (w Window) decay() time.Duration {
+	return w.Span() / 4
+}
+
+// Contains reports whether a story published at publishedAt belongs to the
+// window at now.
+func (w Window) Contains(publishedAt, now time.Time) bool {
+	return !publishedAt.After(now) && now.Sub(publishedAt) < w.Span()
+}
+
+// Score returns the hotness of a story. The engagement counts on a log scale
+// and newer stories get a bonus that grows linearly with publication time,
+// which decays older stories without the score having to change over time.
+// Scores only compare within one window.
+func Score(w Window, likes, comments, views int64, publishedAt time.Time) float64 {
+	points := LikeWeight*float64(max(likes, 0)) +
+		CommentWeight*float64(max(comments, 0)) +
+		ViewWeight*float64(max(views, 0))
+	age := float64(publishedAt.Unix()) / w.decay().Seconds()
+	return math.Log10(1+points) + age
+}
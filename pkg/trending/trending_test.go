@@ -0,0 +1,63 @@
+package trending
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWindow(t *testing.T) {
+	for in, want := range map[string]Window{"": Day, "day": Day, "week": Week, "month": Month} {
+		got, err := ParseWindow(in)
+		if err != nil || got != want {
+			t.Errorf("ParseWindow(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseWindow("year"); err == nil {
+		t.Error("ParseWindow(year) succeeded")
+	}
+}
+
+func TestContains(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		w         Window
+		published time.Time
+		want      bool
+	}{
+		{Day, now.Add(-time.Hour), true},
+		{Day, now.Add(-25 * time.Hour), false},
+		{Week, now.Add(-25 * time.Hour), true},
+		{Week, now.Add(-8 * 24 * time.Hour), false},
+		{Month, now.Add(-8 * 24 * time.Hour), true},
+		{Month, now.Add(time.Hour), false},
+	}
+	for _, tt := range tests {
+		if got := tt.w.Contains(tt.published, now); got != tt.want {
+			t.Errorf("%s.Contains(%v) = %v, want %v", tt.w, tt.published, got, tt.want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	if Score(Day, 10, 0, 0, now) <= Score(Day, 5, 0, 0, now) {
+		t.Error("more likes should score higher")
+	}
+	if Score(Day, 0, 1, 0, now) <= Score(Day, 1, 0, 0, now) {
+		t.Error("a comment should outweigh a like")
+	}
+	if Score(Day, 0, 0, 0, now) != Score(Day, -3, 0, 0, now) {
+		t.Error("negative counts should count as zero")
+	}
+
+	// A story a quarter day older needs ten times the engagement to tie.
+	older := now.Add(-6 * time.Hour)
+	if got, want := Score(Day, 99, 0, 0, older), Score(Day, 9, 0, 0, now); got-want > 1e-9 || want-got > 1e-9 {
+		t.Errorf("Score(older) = %v, want %v", got, want)
+	}
+	// The same age counts for less in longer windows.
+	if Score(Month, 9, 0, 0, now)-Score(Month, 99, 0, 0, older) >= 1 {
+		t.Error("month window should decay slower than day window")
+	}
+}
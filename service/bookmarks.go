@@ -0,0 +1,39 @@
+package service
+
+import (
+	pb "content/genproto/content"
+	"context"
+)
+
+func (u *ContentService) AddBookmark(ctx context.Context, req *pb.BookmarkReq) (*pb.Bookmark, error) {
+	u.Log.Info("AddBookmark rpc method started")
+	res, err := u.Repo.AddBookmark(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("AddBookmark rpc method finished")
+	return res, nil
+}
+
+func (u *ContentService) RemoveBookmark(ctx context.Context, req *pb.BookmarkReq) (*pb.Void, error) {
+	u.Log.Info("RemoveBookmark rpc method started")
+	err := u.Repo.RemoveBookmark(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("RemoveBookmark rpc method finished")
+	return &pb.Void{}, nil
+}
+
+func (u *ContentService) ListBookmarks(ctx context.Context, req *pb.ListBookmarksReq) (*pb.ListBookmarksRes, error) {
+	u.Log.Info("ListBookmarks rpc method started")
+	res, err := u.Repo.ListBookmarks(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("ListBookmarks rpc method finished")
+	return res, nil
+}
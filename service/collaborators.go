@@ -0,0 +1,64 @@
+package service
+
+import (
+	pb "content/genproto/story"
+	"content/storage/postgres"
+	"content/storage/redis"
+	"context"
+)
+
+func (u *StoryService) InviteCollaborator(ctx context.Context, req *pb.InviteCollaboratorReq) (*pb.StoryCollaborator, error) {
+	u.Log.Info("InviteCollaborator rpc method started")
+	res, err := u.Repo.InviteCollaborator(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	if res.Status == postgres.CollaborationPending {
+		err := u.Events.Publish(ctx, redis.CollaboratorInvitedChannel, redis.CollaboratorInvitedEvent{
+			StoryId:   res.StoryId,
+			UserId:    res.User.UserId,
+			InvitedBy: res.InvitedBy,
+			Role:      res.Role,
+			CreatedAt: res.CreatedAt,
+		})
+		if err != nil {
+			u.Log.Error(err.Error())
+		}
+	}
+	u.Log.Info("InviteCollaborator rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) AcceptCollaboration(ctx context.Context, req *pb.CollaborationReq) (*pb.StoryCollaborator, error) {
+	u.Log.Info("AcceptCollaboration rpc method started")
+	res, err := u.Repo.AcceptCollaboration(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("AcceptCollaboration rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) RemoveCollaborator(ctx context.Context, req *pb.RemoveCollaboratorReq) (*pb.Void, error) {
+	u.Log.Info("RemoveCollaborator rpc method started")
+	err := u.Repo.RemoveCollaborator(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("RemoveCollaborator rpc method finished")
+	return &pb.Void{}, nil
+}
+
+func (u *StoryService) ListCollaborators(ctx context.Context, req *pb.CollaborationReq) (*pb.ListCollaboratorsRes, error) {
+	u.Log.Info("ListCollaborators rpc method started")
+	res, err := u.Repo.ListCollaborators(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("ListCollaborators rpc method finished")
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	pb "content/genproto/story"
+	"context"
+)
+
+func (u *StoryService) CreateCollection(ctx context.Context, req *pb.CreateCollectionReq) (*pb.Collection, error) {
+	u.Log.Info("CreateCollection rpc method started")
+	res, err := u.Repo.CreateCollection(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("CreateCollection rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionReq) (*pb.Collection, error) {
+	u.Log.Info("UpdateCollection rpc method started")
+	res, err := u.Repo.UpdateCollection(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("UpdateCollection rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) DeleteCollection(ctx context.Context, req *pb.CollectionReq) (*pb.Void, error) {
+	u.Log.Info("DeleteCollection rpc method started")
+	err := u.Repo.DeleteCollection(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("DeleteCollection rpc method finished")
+	return &pb.Void{}, nil
+}
+
+func (u *StoryService) ListCollections(ctx context.Context, req *pb.ListCollectionsReq) (*pb.ListCollectionsRes, error) {
+	u.Log.Info("ListCollections rpc method started")
+	res, err := u.Repo.ListCollections(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("ListCollections rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) GetCollection(ctx context.Context, req *pb.GetCollectionReq) (*pb.GetCollectionRes, error) {
+	u.Log.Info("GetCollection rpc method started")
+	res, err := u.Repo.GetCollection(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("GetCollection rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) AddCollectionStory(ctx context.Context, req *pb.CollectionStoryReq) (*pb.Collection, error) {
+	u.Log.Info("AddCollectionStory rpc method started")
+	res, err := u.Repo.AddCollectionStory(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("AddCollectionStory rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) RemoveCollectionStory(ctx context.Context, req *pb.CollectionStoryReq) (*pb.Collection, error) {
+	u.Log.Info("RemoveCollectionStory rpc method started")
+	res, err := u.Repo.RemoveCollectionStory(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("RemoveCollectionStory rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) ReorderCollection(ctx context.Context, req *pb.ReorderCollectionReq) (*pb.Collection, error) {
+	u.Log.Info("ReorderCollection rpc method started")
+	res, err := u.Repo.ReorderCollection(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("ReorderCollection rpc method finished")
+	return res, nil
+}
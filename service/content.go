@@ -1,25 +1,51 @@
 package service
 
 import (
+	"content/config"
 	pb "content/genproto/content"
 	"content/logger"
+	"content/storage/blob"
 	"content/storage/postgres"
 	"content/storage/redis"
 	"context"
 	"database/sql"
 	"log/slog"
+
+	goredis "github.com/redis/go-redis/v9"
 )
 
 type ContentService struct {
 	pb.UnimplementedContentServer
-	Repo *postgres.ContentRepo
-	Log  *slog.Logger
+	Repo       *postgres.ContentRepo
+	Log        *slog.Logger
+	Erasure    config.ErasureConfig
+	Media      config.MediaConfig
+	Blobs      blob.Store
+	Feed       config.FeedConfig
+	Timelines  *redis.Timelines
+	Moderation config.ModerationConfig
+	Events     *redis.Publisher
+	Moderator  *Moderator
+	Stories    *StoryService
 }
 
-func NewContentService(db *sql.DB) *ContentService {
+// NewContentService creates the content service. Stories announces the
+// stories that moderators let through.
+func NewContentService(db *sql.DB, rdb *goredis.Client, blobs blob.Store, stories *StoryService) *ContentService {
+	cfg := config.Load()
+	log := logger.NewLogger()
 	return &ContentService{
-		Repo: postgres.NewContentRepository(db),
-		Log:  logger.NewLogger(),
+		Repo:       postgres.NewContentRepository(db),
+		Log:        log,
+		Erasure:    cfg.Erasure,
+		Media:      cfg.Media,
+		Blobs:      blobs,
+		Feed:       cfg.Feed,
+		Timelines:  redis.NewTimelines(rdb, cfg.Feed.FEED_TIMELINE_SIZE, cfg.Feed.FEED_TIMELINE_TTL),
+		Moderation: cfg.Moderation,
+		Events:     redis.NewPublisher(rdb),
+		Moderator:  NewModerator(rdb, cfg.Moderation, log),
+		Stories:    stories,
 	}
 }
 
@@ -44,11 +70,20 @@ func (u *ContentService) GetDestinationsById(ctx context.Context, req *pb.GetDes
 }
 func (u *ContentService) SendMessage(ctx context.Context, req *pb.SendMessageReq) (*pb.SendMessageRes, error) {
 	u.Log.Info("SendMessage rpc method started")
-	res, err := u.Repo.SendMessage(ctx, req)
+	review, err := u.Moderator.Check(ctx, req.UserId, postgres.ReportTypeMessage, req.Content)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	res, err := u.Repo.SendMessage(ctx, req, review.Hold())
 	if err != nil {
 		u.Log.Error(err.Error())
 		return nil, err
 	}
+	res.ModerationStatus = review.Status()
+	if err := u.Moderator.Stored(ctx, review); err != nil {
+		u.Log.Error(err.Error())
+	}
 	u.Log.Info("SendMessage rpc method finished")
 	return res, nil
 }
@@ -64,11 +99,20 @@ func (u *ContentService) GetMessages(ctx context.Context, req *pb.GetMessagesReq
 }
 func (u *ContentService) CreateTips(ctx context.Context, req *pb.CreateTipsReq) (*pb.CreateTipsRes, error) {
 	u.Log.Info("CreateTips rpc method started")
-	res, err := u.Repo.CreateTips(ctx, req)
+	review, err := u.Moderator.Check(ctx, req.UserId, postgres.ReportTypeTip, req.Title, req.Content)
 	if err != nil {
 		u.Log.Error(err.Error())
 		return nil, err
 	}
+	res, err := u.Repo.CreateTips(ctx, req, review.Hold())
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	res.ModerationStatus = review.Status()
+	if err := u.Moderator.Stored(ctx, review); err != nil {
+		u.Log.Error(err.Error())
+	}
 	u.Log.Info("CreateTips rpc method finished")
 	return res, nil
 }
@@ -103,3 +147,45 @@ func (u *ContentService) TopDestinations(ctx context.Context, req *pb.Void) (*pb
 	u.Log.Info("TopDestinations rpc method finished")
 	return res, nil
 }
+
+func (u *ContentService) EraseUserContent(ctx context.Context, req *pb.EraseUserContentReq) (*pb.EraseUserContentRes, error) {
+	u.Log.Info("EraseUserContent rpc method started")
+	if req.Policy == "" {
+		req.Policy = u.Erasure.ERASURE_POLICY
+	}
+	res, err := u.Repo.EraseUserContent(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("EraseUserContent rpc method finished")
+	return res, nil
+}
+
+// ConsumeUserDeleted erases the content of every account the users service
+// reports as deleted, using the configured erasure policy.
+func (u *ContentService) ConsumeUserDeleted(ctx context.Context) error {
+	return redis.SubscribeUserDeleted(ctx, u.Erasure.USER_DELETED_CHANNEL, func(ctx context.Context, userID string) error {
+		res, err := u.EraseUserContent(ctx, &pb.EraseUserContentReq{UserId: userID})
+		if err != nil {
+			return err
+		}
+		u.Log.Info("erased user content",
+			"user_id", res.UserId, "policy", res.Policy,
+			"stories", res.Stories, "itineraries", res.Itineraries,
+			"story_comments", res.StoryComments, "itinerary_comments", res.ItineraryComments,
+			"likes", res.Likes, "messages", res.Messages, "tips", res.Tips)
+		return nil
+	})
+}
+
+func (u *ContentService) FindNearby(ctx context.Context, req *pb.FindNearbyReq) (*pb.FindNearbyRes, error) {
+	u.Log.Info("FindNearby rpc method started")
+	res, err := u.Repo.FindNearby(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("FindNearby rpc method finished")
+	return res, nil
+}
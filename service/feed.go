@@ -0,0 +1,153 @@
+package service
+
+import (
+	pb "content/genproto/content"
+	"content/pkg/cursor"
+	"content/storage/postgres"
+	"content/storage/redis"
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxTimelinePages bounds how many timeline batches one feed request reads
+// when entries turn out to be deleted or unfollowed.
+const maxTimelinePages = 3
+
+func (u *ContentService) GetFeed(ctx context.Context, req *pb.GetFeedReq) (*pb.GetFeedRes, error) {
+	u.Log.Info("GetFeed rpc method started")
+	res, err := u.getFeed(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("GetFeed rpc method finished")
+	return res, nil
+}
+
+// getFeed serves heavy readers from their redis timeline and everybody else,
+// or anything past the end of a timeline, from the database. Both sources use
+// the same cursors, so a client can page across them.
+func (u *ContentService) getFeed(ctx context.Context, req *pb.GetFeedReq) (*pb.GetFeedRes, error) {
+	if req.Limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive")
+	}
+	after, err := cursor.Parse(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []postgres.FeedEntry
+	if u.isHeavyReader(ctx, req.UserId) {
+		entries, after, err = u.timelineFeed(ctx, req.UserId, after, req.Limit)
+		if err != nil {
+			// The database can always answer on its own.
+			u.Log.Error(err.Error())
+			entries = nil
+		}
+	}
+
+	if missing := req.Limit - int64(len(entries)); missing > 0 {
+		more, err := u.Repo.GetFeed(ctx, req.UserId, after, missing)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, more...)
+	}
+
+	res := &pb.GetFeedRes{}
+	for _, entry := range entries {
+		res.Items = append(res.Items, entry.Item)
+	}
+	if len(entries) > 0 && int64(len(entries)) == req.Limit {
+		res.NextCursor = entries[len(entries)-1].Position.String()
+	}
+
+	return res, nil
+}
+
+func (u *ContentService) isHeavyReader(ctx context.Context, userID string) bool {
+	reads, err := u.Timelines.RecordRead(ctx, userID)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return false
+	}
+	return reads >= u.Feed.FEED_HEAVY_READER_THRESHOLD
+}
+
+// timelineFeed reads up to limit entries from the user's timeline, building
+// it first if needed. It returns the position it stopped at so the caller
+// can continue from the database when the timeline runs out.
+func (u *ContentService) timelineFeed(ctx context.Context, userID string, after *cursor.Cursor, limit int64) ([]postgres.FeedEntry, *cursor.Cursor, error) {
+	exists, err := u.Timelines.Exists(ctx, userID)
+	if err != nil {
+		return nil, after, err
+	}
+	if !exists {
+		if err := u.buildTimeline(ctx, userID); err != nil {
+			return nil, after, err
+		}
+	}
+
+	var entries []postgres.FeedEntry
+	for page := 0; page < maxTimelinePages && int64(len(entries)) < limit; page++ {
+		positions, err := u.Timelines.Range(ctx, userID, after, limit-int64(len(entries)))
+		if err != nil {
+			return nil, after, err
+		}
+		if len(positions) == 0 {
+			break
+		}
+
+		keys := make([]string, len(positions))
+		for i, p := range positions {
+			keys[i] = p.Key
+		}
+		found, err := u.Repo.FeedEntries(ctx, userID, keys)
+		if err != nil {
+			return nil, after, err
+		}
+
+		for _, p := range positions {
+			if entry, ok := found[p.Key]; ok {
+				entries = append(entries, entry)
+			}
+		}
+		last := positions[len(positions)-1]
+		after = &last
+	}
+
+	return entries, after, nil
+}
+
+func (u *ContentService) buildTimeline(ctx context.Context, userID string) error {
+	entries, err := u.Repo.GetFeed(ctx, userID, nil, u.Feed.FEED_TIMELINE_SIZE)
+	if err != nil {
+		return err
+	}
+
+	positions := make([]cursor.Cursor, len(entries))
+	for i, entry := range entries {
+		positions[i] = entry.Position
+	}
+	return u.Timelines.Fill(ctx, userID, positions)
+}
+
+type followerLister interface {
+	FollowerIDs(ctx context.Context, userID string) ([]string, error)
+}
+
+// fanOut pushes a newly published item into the timelines of the author's
+// followers. Followers without a timeline are skipped; they read the item
+// from the database.
+func fanOut(ctx context.Context, repo followerLister, timelines *redis.Timelines, authorID, key, publishedAt string) error {
+	ts, err := time.Parse(time.RFC3339Nano, publishedAt)
+	if err != nil {
+		return err
+	}
+	followers, err := repo.FollowerIDs(ctx, authorID)
+	if err != nil || len(followers) == 0 {
+		return err
+	}
+	return timelines.Push(ctx, followers, cursor.Cursor{Score: ts.UnixMilli(), Key: key})
+}
@@ -1,24 +1,37 @@
 package service
 
 import (
+	"content/config"
 	pb "content/genproto/itineraries"
 	"content/logger"
+	"content/pkg/moderation"
 	"content/storage/postgres"
+	"content/storage/redis"
 	"context"
 	"database/sql"
 	"log/slog"
+
+	goredis "github.com/redis/go-redis/v9"
 )
 
 type ItinerariesService struct {
 	pb.UnimplementedItinerariesServer
-	Repo *postgres.ItinerariesRepo
-	Log  *slog.Logger
+	Repo      *postgres.ItinerariesRepo
+	Log       *slog.Logger
+	Events    *redis.Publisher
+	Timelines *redis.Timelines
+	Moderator *Moderator
 }
 
-func NewItinerariesService(db *sql.DB) *ItinerariesService {
+func NewItinerariesService(db *sql.DB, rdb *goredis.Client) *ItinerariesService {
+	cfg := config.Load()
+	log := logger.NewLogger()
 	return &ItinerariesService{
-		Repo: postgres.NewItinerariesRepository(db),
-		Log:  logger.NewLogger(),
+		Repo:      postgres.NewItinerariesRepository(db),
+		Log:       log,
+		Events:    redis.NewPublisher(rdb),
+		Timelines: redis.NewTimelines(rdb, cfg.Feed.FEED_TIMELINE_SIZE, cfg.Feed.FEED_TIMELINE_TTL),
+		Moderator: NewModerator(rdb, cfg.Moderation, log),
 	}
 }
 
@@ -29,6 +42,9 @@ func (u *ItinerariesService) Itineraries(ctx context.Context, req *pb.Itinerarie
 		u.Log.Error(err.Error())
 		return nil, err
 	}
+	if err := fanOut(ctx, u.Repo, u.Timelines, res.UserId, "itinerary:"+res.Id, res.CreatedAt); err != nil {
+		u.Log.Error(err.Error())
+	}
 	u.Log.Info("Itineraries rpc method finished")
 	return res, nil
 }
@@ -76,11 +92,58 @@ func (u *ItinerariesService) GetItinerariesById(ctx context.Context, req *pb.Sto
 }
 func (u *ItinerariesService) CommentItineraries(ctx context.Context, req *pb.CommentItinerariesReq) (*pb.CommentItinerariesRes, error) {
 	u.Log.Info("CommentItineraries rpc method started")
-	res, err := u.Repo.CommentItineraries(ctx, req)
+	review, err := u.Moderator.Check(ctx, req.AuthorId, postgres.ReportTypeItineraryComment, req.Content)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	res, mentions, err := u.Repo.CommentItineraries(ctx, req, review.Hold())
 	if err != nil {
 		u.Log.Error(err.Error())
 		return nil, err
 	}
+	res.ModerationStatus = review.Status()
+	if err := u.Moderator.Stored(ctx, review); err != nil {
+		u.Log.Error(err.Error())
+	}
+	if review.Action != moderation.Hold {
+		if err := publishMentions(ctx, u.Events, mentions); err != nil {
+			u.Log.Error(err.Error())
+		}
+	}
 	u.Log.Info("CommentItineraries rpc method finished")
 	return res, nil
 }
+
+func (u *ItinerariesService) EditComment(ctx context.Context, req *pb.EditCommentReq) (*pb.CommentItinerariesRes, error) {
+	u.Log.Info("EditComment rpc method started")
+	res, err := u.Repo.EditComment(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("EditComment rpc method finished")
+	return res, nil
+}
+
+func (u *ItinerariesService) DeleteComment(ctx context.Context, req *pb.DeleteCommentReq) (*pb.Void, error) {
+	u.Log.Info("DeleteComment rpc method started")
+	err := u.Repo.DeleteComment(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("DeleteComment rpc method finished")
+	return &pb.Void{}, nil
+}
+
+func (u *ItinerariesService) SetItineraryMedia(ctx context.Context, req *pb.SetItineraryMediaReq) (*pb.ItineraryMediaRes, error) {
+	u.Log.Info("SetItineraryMedia rpc method started")
+	res, err := u.Repo.SetItineraryMedia(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("SetItineraryMedia rpc method finished")
+	return res, nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"bytes"
+	pb "content/genproto/content"
+	"content/pkg/imaging"
+	"content/pkg/media"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+const maxAltTextLength = 500
+
+func (u *ContentService) UploadMedia(stream pb.Content_UploadMediaServer) error {
+	u.Log.Info("UploadMedia rpc method started")
+	res, err := u.uploadMedia(stream)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return err
+	}
+	u.Log.Info("UploadMedia rpc method finished")
+	return stream.SendAndClose(res)
+}
+
+// uploadMedia expects a MediaInfo message followed by the file in chunks. The
+// file is buffered in memory up to the configured size limit before it is
+// inspected, stripped of its GPS data unless the owner asked to keep it, and
+// written to the blob store. The stored URL is public right away, so the
+// location must be gone by then. Renditions are produced later by the media
+// pipeline.
+func (u *ContentService) uploadMedia(stream pb.Content_UploadMediaServer) (*pb.Media, error) {
+	first, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return nil, fmt.Errorf("the first upload message must carry media info")
+	}
+	if info.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	if len(info.AltText) > maxAltTextLength {
+		return nil, fmt.Errorf("alt text is longer than %d characters", maxAltTextLength)
+	}
+
+	var buf bytes.Buffer
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if int64(buf.Len()+len(msg.GetChunk())) > u.Media.MEDIA_MAX_SIZE {
+			return nil, fmt.Errorf("upload is larger than %d bytes", u.Media.MEDIA_MAX_SIZE)
+		}
+		buf.Write(msg.GetChunk())
+	}
+
+	data := buf.Bytes()
+	if !info.KeepLocation {
+		stripped, _, err := imaging.StripGPS(data)
+		if err != nil {
+			return nil, err
+		}
+		data = stripped
+	}
+
+	meta, err := media.Inspect(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := newMediaDir()
+	if err != nil {
+		return nil, err
+	}
+	key := dir + "/original" + meta.Ext
+	if _, err := u.Blobs.Put(stream.Context(), key, bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed to store media: %v", err)
+	}
+
+	return u.Repo.CreateMedia(stream.Context(), &pb.Media{
+		OwnerId:     info.UserId,
+		Url:         u.Blobs.URL(key),
+		ContentType: meta.ContentType,
+		Size:        meta.Size,
+		Width:       int64(meta.Width),
+		Height:      int64(meta.Height),
+		Checksum:    meta.Checksum,
+		AltText:     info.AltText,
+	}, key, info.KeepLocation)
+}
+
+// newMediaDir returns a random blob directory for one upload. The original and
+// its renditions are stored side by side in it.
+func newMediaDir() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(b)
+	return "media/" + id[:2] + "/" + id, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"bytes"
+	"content/pkg/imaging"
+	"content/pkg/media"
+	"content/storage/postgres"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"path"
+	"time"
+)
+
+const (
+	mediaBatchSize  = 10
+	mediaStaleAfter = 10 * time.Minute
+)
+
+var renditionSizes = []struct {
+	Name string
+	Max  int
+}{
+	{Name: "thumbnail", Max: 320},
+	{Name: "medium", Max: 1280},
+}
+
+// RunMediaPipeline processes pending uploads every interval until ctx is
+// cancelled.
+func (u *ContentService) RunMediaPipeline(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pending, err := u.Repo.ClaimPendingMedia(ctx, mediaBatchSize, mediaStaleAfter)
+			if err != nil {
+				u.Log.Error(err.Error())
+				continue
+			}
+			for _, item := range pending {
+				u.processMedia(ctx, item)
+			}
+		}
+	}
+}
+
+func (u *ContentService) processMedia(ctx context.Context, item postgres.PendingMedia) {
+	processed, err := u.renderMedia(ctx, item)
+	if err != nil {
+		u.Log.Error(err.Error(), "media_id", item.Id)
+		if err := u.Repo.FailMedia(ctx, item.Id, err.Error()); err != nil {
+			u.Log.Error(err.Error())
+		}
+		return
+	}
+
+	if err := u.Repo.CompleteMedia(ctx, *processed); err != nil {
+		u.Log.Error(err.Error(), "media_id", item.Id)
+		return
+	}
+	u.Log.Info("media processed", "media_id", item.Id)
+}
+
+// renderMedia validates a stored upload, strips its GPS data unless the owner
+// asked to keep it, and writes the renditions next to the original. Uploads
+// are stripped when they are received; stripping again here covers files
+// stored before that.
+func (u *ContentService) renderMedia(ctx context.Context, item postgres.PendingMedia) (*postgres.ProcessedMedia, error) {
+	r, err := u.Blobs.Get(ctx, item.StorageKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media: %v", err)
+	}
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media: %v", err)
+	}
+
+	meta, err := media.Inspect(data)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", meta.ContentType, err)
+	}
+
+	if !item.KeepLocation {
+		stripped, found, err := imaging.StripGPS(data)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			if _, err := u.Blobs.Put(ctx, item.StorageKey, bytes.NewReader(stripped)); err != nil {
+				return nil, fmt.Errorf("failed to store media: %v", err)
+			}
+			if meta, err = media.Inspect(stripped); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	img = imaging.Orient(img, imaging.Orientation(data))
+	bounds := img.Bounds()
+	processed := &postgres.ProcessedMedia{
+		Id:       item.Id,
+		Size:     meta.Size,
+		Checksum: meta.Checksum,
+		Width:    bounds.Dx(),
+		Height:   bounds.Dy(),
+	}
+
+	for _, spec := range renditionSizes {
+		scaled := imaging.Fit(img, spec.Max, spec.Max)
+		if processed.DominantColor == "" {
+			processed.DominantColor = imaging.DominantColor(scaled)
+		}
+
+		var buf bytes.Buffer
+		ext := ".png"
+		if meta.ContentType == "image/jpeg" {
+			ext = ".jpg"
+			err = jpeg.Encode(&buf, scaled, &jpeg.Options{Quality: 85})
+		} else {
+			err = png.Encode(&buf, scaled)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		key := path.Join(path.Dir(item.StorageKey), spec.Name+ext)
+		n, err := u.Blobs.Put(ctx, key, &buf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store %s rendition: %v", spec.Name, err)
+		}
+
+		b := scaled.Bounds()
+		processed.Renditions = append(processed.Renditions, postgres.MediaRendition{
+			Name:       spec.Name,
+			StorageKey: key,
+			Url:        u.Blobs.URL(key),
+			Width:      b.Dx(),
+			Height:     b.Dy(),
+			Size:       n,
+		})
+	}
+
+	return processed, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	pb "content/genproto/content"
+	"content/storage/postgres"
+	"content/storage/redis"
+	"context"
+)
+
+func (u *ContentService) ListMentions(ctx context.Context, req *pb.ListMentionsReq) (*pb.ListMentionsRes, error) {
+	u.Log.Info("ListMentions rpc method started")
+	res, err := u.Repo.ListMentions(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("ListMentions rpc method finished")
+	return res, nil
+}
+
+// publishMentions emits a mention event per mentioned user so the
+// notification service can tell them. It keeps going after a failure and
+// returns the first error.
+func publishMentions(ctx context.Context, events *redis.Publisher, mentions []postgres.Mention) error {
+	var first error
+	for _, m := range mentions {
+		err := events.Publish(ctx, redis.MentionCreatedChannel, redis.MentionEvent{
+			MentionId:       m.Id,
+			MentionedUserId: m.MentionedUserId,
+			AuthorId:        m.AuthorId,
+			SourceType:      m.SourceType,
+			SourceId:        m.SourceId,
+			TargetType:      m.TargetType,
+			TargetId:        m.TargetId,
+			CreatedAt:       m.CreatedAt,
+		})
+		if err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
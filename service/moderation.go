@@ -0,0 +1,96 @@
+package service
+
+import (
+	"content/config"
+	"content/pkg/moderation"
+	"content/storage/postgres"
+	"content/storage/redis"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Moderator runs user submitted text through the moderation pipeline before
+// it is stored.
+type Moderator struct {
+	Pipeline *moderation.Pipeline
+}
+
+// NewModerator creates the moderator. A filter that fails, such as the
+// duplicate check while redis is down, is logged to log and skipped rather
+// than blocking the write.
+func NewModerator(rdb *goredis.Client, cfg config.ModerationConfig, log *slog.Logger) *Moderator {
+	filters := []moderation.Filter{
+		&moderation.LinkCount{Max: cfg.MODERATION_MAX_LINKS, Score: 1},
+		&moderation.Repetition{MinWords: 8, MaxWordShare: 0.5, MaxRun: 10, Score: 1},
+		&moderation.Duplicate{
+			Store:     redis.NewFingerprints(rdb),
+			Window:    cfg.MODERATION_DUPLICATE_WINDOW,
+			MinLength: 20,
+			Score:     2,
+		},
+	}
+	if len(cfg.MODERATION_FLAGGED_WORDS) > 0 {
+		filters = append([]moderation.Filter{&moderation.WordList{Words: cfg.MODERATION_FLAGGED_WORDS, Score: 1}}, filters...)
+	}
+	if len(cfg.MODERATION_BLOCKED_WORDS) > 0 {
+		filters = append([]moderation.Filter{&moderation.WordList{Words: cfg.MODERATION_BLOCKED_WORDS, Reject: true}}, filters...)
+	}
+
+	return &Moderator{
+		Pipeline: &moderation.Pipeline{
+			Filters:     filters,
+			HoldScore:   cfg.MODERATION_HOLD_SCORE,
+			RejectScore: cfg.MODERATION_REJECT_SCORE,
+			OnError: func(err error) {
+				log.Error(err.Error())
+			},
+		},
+	}
+}
+
+// Review is the moderation verdict on one submission.
+type Review struct {
+	moderation.Verdict
+	content moderation.Content
+}
+
+// Hold returns how the content is to be held when it is stored, or nil when
+// it goes up right away.
+func (r *Review) Hold() *postgres.Hold {
+	if r.Action != moderation.Hold {
+		return nil
+	}
+	return &postgres.Hold{Reasons: r.Reasons}
+}
+
+// Status is the moderation status reported to the author.
+func (r *Review) Status() string {
+	return string(r.Action)
+}
+
+// Check runs the pipeline and turns a rejection into an error.
+func (m *Moderator) Check(ctx context.Context, authorID, kind string, text ...string) (*Review, error) {
+	content := moderation.Content{
+		AuthorID: authorID,
+		Kind:     kind,
+		Text:     strings.Join(text, "\n"),
+	}
+	verdict, err := m.Pipeline.Run(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+	if verdict.Action == moderation.Reject {
+		return nil, fmt.Errorf("content rejected by moderation: %s", strings.Join(verdict.Reasons, "; "))
+	}
+	return &Review{Verdict: verdict, content: content}, nil
+}
+
+// Stored records the reviewed content with the filters that remember what
+// was posted. It is called once the content is stored.
+func (m *Moderator) Stored(ctx context.Context, r *Review) error {
+	return m.Pipeline.Record(ctx, r.content)
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+const readingStatsBatchSize = 100
+
+// RunReadingStatsBackfill computes the reading statistics of stories stored
+// without them or with outdated ones, right away and then every interval
+// until ctx is cancelled. Each run works through all such stories in
+// batches.
+func (u *StoryService) RunReadingStatsBackfill(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			n, err := u.Repo.BackfillReadingStats(ctx, readingStatsBatchSize)
+			if err != nil {
+				u.Log.Error(err.Error())
+				break
+			}
+			if n > 0 {
+				u.Log.Info("reading stats backfilled", "stories", n)
+			}
+			if n < readingStatsBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
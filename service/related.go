@@ -0,0 +1,74 @@
+package service
+
+import (
+	pb "content/genproto/story"
+	"content/pkg/related"
+	"context"
+	"fmt"
+)
+
+const (
+	// relatedCandidates bounds how many candidate stories are scored.
+	relatedCandidates = 500
+	// maxRelatedStories is how many related stories are ranked and cached
+	// per story. Readers are served from the top of the ranking after their
+	// own and already liked stories are skipped.
+	maxRelatedStories = 100
+)
+
+func (u *StoryService) GetRelatedStories(ctx context.Context, req *pb.GetRelatedStoriesReq) (*pb.GetAllStoriesRes, error) {
+	u.Log.Info("GetRelatedStories rpc method started")
+	res, err := u.getRelatedStories(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("GetRelatedStories rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) getRelatedStories(ctx context.Context, req *pb.GetRelatedStoriesReq) (*pb.GetAllStoriesRes, error) {
+	if req.Limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive")
+	}
+	if err := u.Repo.StoryViewable(ctx, req.StoryId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	ranked, err := u.relatedRanking(ctx, req.StoryId)
+	if err != nil {
+		return nil, err
+	}
+	if len(ranked) == 0 {
+		return &pb.GetAllStoriesRes{Limit: req.Limit}, nil
+	}
+
+	ids := make([]string, len(ranked))
+	for i, r := range ranked {
+		ids[i] = r.StoryId
+	}
+	return u.Repo.GetRelatedStories(ctx, ids, req)
+}
+
+// relatedRanking returns the cached ranking of a story, scoring it on a
+// cache miss. A broken cache only costs scoring on every read.
+func (u *StoryService) relatedRanking(ctx context.Context, storyID string) ([]related.Scored, error) {
+	ranked, ok, err := u.Related.Get(ctx, storyID)
+	if err != nil {
+		u.Log.Error(err.Error())
+	}
+	if ok {
+		return ranked, nil
+	}
+
+	candidates, err := u.Repo.RelatedCandidates(ctx, storyID, relatedCandidates)
+	if err != nil {
+		return nil, err
+	}
+	ranked = related.Rank(candidates, maxRelatedStories)
+
+	if err := u.Related.Set(ctx, storyID, ranked); err != nil {
+		u.Log.Error(err.Error())
+	}
+	return ranked, nil
+}
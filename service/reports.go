@@ -0,0 +1,64 @@
+package service
+
+import (
+	pb "content/genproto/content"
+	"content/storage/postgres"
+	"content/storage/redis"
+	"context"
+)
+
+func (u *ContentService) ReportContent(ctx context.Context, req *pb.ReportContentReq) (*pb.Report, error) {
+	u.Log.Info("ReportContent rpc method started")
+	res, err := u.Repo.ReportContent(ctx, req, u.Moderation.MODERATION_AUTO_HIDE_THRESHOLD)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("ReportContent rpc method finished")
+	return res, nil
+}
+
+func (u *ContentService) ListReports(ctx context.Context, req *pb.ListReportsReq) (*pb.ListReportsRes, error) {
+	u.Log.Info("ListReports rpc method started")
+	res, err := u.Repo.ListReports(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("ListReports rpc method finished")
+	return res, nil
+}
+
+func (u *ContentService) ResolveReport(ctx context.Context, req *pb.ResolveReportReq) (*pb.ResolveReportRes, error) {
+	u.Log.Info("ResolveReport rpc method started")
+	res, released, err := u.Repo.ResolveReport(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	if released.Story != nil {
+		u.Stories.storyPublished(ctx, *released.Story)
+	}
+	if err := publishMentions(ctx, u.Events, released.Mentions); err != nil {
+		u.Log.Error(err.Error())
+	}
+
+	// The decision is already committed; a lost warning event is logged
+	// rather than reported back to the moderator.
+	if res.Action.Action == postgres.ModerationWarn && res.Action.TargetUserId != "" {
+		event := redis.UserWarnedEvent{
+			UserId:      res.Action.TargetUserId,
+			ModeratorId: res.Action.ModeratorId,
+			EntityType:  res.Action.EntityType,
+			EntityId:    res.Action.EntityId,
+			Note:        res.Action.Note,
+			CreatedAt:   res.Action.CreatedAt,
+		}
+		if err := u.Events.Publish(ctx, redis.UserWarnedChannel, event); err != nil {
+			u.Log.Error(err.Error())
+		}
+	}
+
+	u.Log.Info("ResolveReport rpc method finished")
+	return res, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"content/storage/postgres"
+	"content/storage/redis"
+	"context"
+	"time"
+)
+
+// RunPublishScheduler publishes scheduled stories once their publish_at has
+// passed, checking every interval until ctx is cancelled.
+func (u *StoryService) RunPublishScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stories, err := u.Repo.PublishDueStories(ctx)
+			if err != nil {
+				u.Log.Error(err.Error())
+				continue
+			}
+			for _, story := range stories {
+				u.Log.Info("scheduled story published", "story_id", story.Id)
+				u.storyPublished(ctx, story)
+			}
+		}
+	}
+}
+
+// storyPublished emits the story published event and adds the story to the
+// followers' feed timelines and the trending rankings. It also announces the
+// users mentioned in the story, which stay silent while it is a draft.
+// Failures are logged only, the story itself is already published.
+func (u *StoryService) storyPublished(ctx context.Context, story postgres.PublishedStory) {
+	event := redis.StoryPublishedEvent{
+		StoryId:     story.Id,
+		AuthorId:    story.AuthorId,
+		Title:       story.Title,
+		PublishedAt: story.PublishedAt,
+	}
+	if err := u.Events.Publish(ctx, redis.StoryPublishedChannel, event); err != nil {
+		u.Log.Error(err.Error())
+	}
+	if err := fanOut(ctx, u.Repo, u.Timelines, story.AuthorId, "story:"+story.Id, story.PublishedAt); err != nil {
+		u.Log.Error(err.Error())
+	}
+	u.refreshTrending(ctx, story.Id)
+
+	mentions, err := u.Repo.StoryMentions(ctx, story.Id)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return
+	}
+	if err := publishMentions(ctx, u.Events, mentions); err != nil {
+		u.Log.Error(err.Error())
+	}
+}
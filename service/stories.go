@@ -1,44 +1,78 @@
 package service
 
 import (
+	"content/config"
 	pb "content/genproto/story"
 	"content/logger"
+	"content/pkg/moderation"
 	"content/storage/postgres"
+	"content/storage/redis"
 	"context"
 	"database/sql"
 	"log/slog"
+
+	goredis "github.com/redis/go-redis/v9"
 )
 
 type StoryService struct {
 	pb.UnimplementedStoryServer
-	Repo *postgres.StoryRepo
-	Log  *slog.Logger
+	Repo      *postgres.StoryRepo
+	Log       *slog.Logger
+	Events    *redis.Publisher
+	Timelines *redis.Timelines
+	Trending  *redis.Trending
+	Views     *redis.Views
+	Moderator *Moderator
+	Related   *redis.Related
 }
 
-func NewStoryService(db *sql.DB) *StoryService {
+func NewStoryService(db *sql.DB, rdb *goredis.Client) *StoryService {
+	cfg := config.Load()
+	log := logger.NewLogger()
 	return &StoryService{
-		Repo: postgres.NewStoryRepository(db),
-		Log:  logger.NewLogger(),
+		Repo:      postgres.NewStoryRepository(db),
+		Log:       log,
+		Events:    redis.NewPublisher(rdb),
+		Timelines: redis.NewTimelines(rdb, cfg.Feed.FEED_TIMELINE_SIZE, cfg.Feed.FEED_TIMELINE_TTL),
+		Trending:  redis.NewTrending(rdb),
+		Views:     redis.NewViews(rdb, cfg.Views.VIEW_WINDOW),
+		Moderator: NewModerator(rdb, cfg.Moderation, log),
+		Related:   redis.NewRelated(rdb, cfg.Related.RELATED_CACHE_TTL),
 	}
 }
 
 func (u *StoryService) CreateStories(ctx context.Context, req *pb.CreateStoriesRequest) (*pb.CreateStoriesResponse, error) {
 	u.Log.Info("CreateStories rpc method started")
-	res, err := u.Repo.CreateStory(ctx, req)
+	review, err := u.Moderator.Check(ctx, req.UserId, postgres.ReportTypeStory, req.Title, req.Content)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	res, err := u.Repo.CreateStory(ctx, req, review.Hold())
 	if err != nil {
 		u.Log.Error(err.Error())
 		return nil, err
 	}
+	res.ModerationStatus = review.Status()
+	if err := u.Moderator.Stored(ctx, review); err != nil {
+		u.Log.Error(err.Error())
+	}
+	if res.Status == postgres.StoryStatusPublished && review.Action != moderation.Hold {
+		u.storyPublished(ctx, postgres.PublishedStory{Id: res.Id, AuthorId: res.AuthorId, Title: res.Title, PublishedAt: res.CreatedAt})
+	}
 	u.Log.Info("CreateStories rpc method finished")
 	return res, nil
 }
 func (u *StoryService) UpdateStories(ctx context.Context, req *pb.UpdateStoriesReq) (*pb.UpdateStoriesRes, error) {
 	u.Log.Info("UpdateStories rpc method started")
-	res, err := u.Repo.UpdateStory(ctx, req)
+	res, mentions, err := u.Repo.UpdateStory(ctx, req)
 	if err != nil {
 		u.Log.Error(err.Error())
 		return nil, err
 	}
+	if err := publishMentions(ctx, u.Events, mentions); err != nil {
+		u.Log.Error(err.Error())
+	}
 	u.Log.Info("UpdateStories rpc method finished")
 	return res, nil
 }
@@ -78,11 +112,26 @@ func (u *StoryService) GetStory(ctx context.Context, req *pb.StoryId) (*pb.GetSt
 
 func (u *StoryService) CommentStory(ctx context.Context, req *pb.CommentStoryReq) (*pb.CommentStoryRes, error) {
 	u.Log.Info("CommentStory rpc method started")
-	res, err := u.Repo.CommentToStory(ctx, req)
+	review, err := u.Moderator.Check(ctx, req.AuthorId, postgres.ReportTypeStoryComment, req.Content)
 	if err != nil {
 		u.Log.Error(err.Error())
 		return nil, err
 	}
+	res, mentions, err := u.Repo.CommentToStory(ctx, req, review.Hold())
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	res.ModerationStatus = review.Status()
+	if err := u.Moderator.Stored(ctx, review); err != nil {
+		u.Log.Error(err.Error())
+	}
+	if review.Action != moderation.Hold {
+		if err := publishMentions(ctx, u.Events, mentions); err != nil {
+			u.Log.Error(err.Error())
+		}
+	}
+	u.refreshTrending(ctx, req.StoryId)
 	u.Log.Info("CommentStory rpc method finished")
 	return res, nil
 }
@@ -105,6 +154,212 @@ func (u *StoryService) Like(ctx context.Context, req *pb.LikeReq) (*pb.LikeRes,
 		u.Log.Error(err.Error())
 		return nil, err
 	}
+	u.refreshTrending(ctx, req.StoryId)
 	u.Log.Info("Like rpc method finished")
 	return res, nil
 }
+
+func (u *StoryService) SearchStories(ctx context.Context, req *pb.SearchStoriesReq) (*pb.SearchStoriesRes, error) {
+	u.Log.Info("SearchStories rpc method started")
+	res, err := u.Repo.SearchStories(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("SearchStories rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) GetStoriesByTag(ctx context.Context, req *pb.GetStoriesByTagReq) (*pb.GetAllStoriesRes, error) {
+	u.Log.Info("GetStoriesByTag rpc method started")
+	res, err := u.Repo.GetStoriesByTag(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("GetStoriesByTag rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) GetTrendingTags(ctx context.Context, req *pb.GetTrendingTagsReq) (*pb.TagsRes, error) {
+	u.Log.Info("GetTrendingTags rpc method started")
+	res, err := u.Repo.GetTrendingTags(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("GetTrendingTags rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) AutocompleteTags(ctx context.Context, req *pb.AutocompleteTagsReq) (*pb.TagsRes, error) {
+	u.Log.Info("AutocompleteTags rpc method started")
+	res, err := u.Repo.AutocompleteTags(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("AutocompleteTags rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) AddStoryTags(ctx context.Context, req *pb.StoryTagsReq) (*pb.StoryTagsRes, error) {
+	u.Log.Info("AddStoryTags rpc method started")
+	res, err := u.Repo.AddStoryTags(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("AddStoryTags rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) RemoveStoryTags(ctx context.Context, req *pb.StoryTagsReq) (*pb.StoryTagsRes, error) {
+	u.Log.Info("RemoveStoryTags rpc method started")
+	res, err := u.Repo.RemoveStoryTags(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("RemoveStoryTags rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) Unlike(ctx context.Context, req *pb.LikeReq) (*pb.UnlikeRes, error) {
+	u.Log.Info("Unlike rpc method started")
+	res, err := u.Repo.Unlike(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.refreshTrending(ctx, req.StoryId)
+	u.Log.Info("Unlike rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) GetStoryLikers(ctx context.Context, req *pb.GetStoryLikersReq) (*pb.GetStoryLikersRes, error) {
+	u.Log.Info("GetStoryLikers rpc method started")
+	res, err := u.Repo.GetStoryLikers(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("GetStoryLikers rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) GetCommentReplies(ctx context.Context, req *pb.GetCommentRepliesReq) (*pb.GetCommentRepliesRes, error) {
+	u.Log.Info("GetCommentReplies rpc method started")
+	res, err := u.Repo.GetCommentReplies(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("GetCommentReplies rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) EditComment(ctx context.Context, req *pb.EditCommentReq) (*pb.Comments, error) {
+	u.Log.Info("EditComment rpc method started")
+	res, err := u.Repo.EditComment(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("EditComment rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) DeleteComment(ctx context.Context, req *pb.DeleteCommentReq) (*pb.Void, error) {
+	u.Log.Info("DeleteComment rpc method started")
+	err := u.Repo.DeleteComment(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("DeleteComment rpc method finished")
+	return &pb.Void{}, nil
+}
+
+func (u *StoryService) ChangeStoryStatus(ctx context.Context, req *pb.ChangeStoryStatusReq) (*pb.StoryStatusRes, error) {
+	u.Log.Info("ChangeStoryStatus rpc method started")
+	res, published, err := u.Repo.ChangeStoryStatus(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	if published != nil {
+		u.storyPublished(ctx, *published)
+	}
+	u.Log.Info("ChangeStoryStatus rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) ListDrafts(ctx context.Context, req *pb.ListDraftsReq) (*pb.GetAllStoriesRes, error) {
+	u.Log.Info("ListDrafts rpc method started")
+	res, err := u.Repo.ListDrafts(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("ListDrafts rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) ListStoryRevisions(ctx context.Context, req *pb.ListStoryRevisionsReq) (*pb.ListStoryRevisionsRes, error) {
+	u.Log.Info("ListStoryRevisions rpc method started")
+	res, err := u.Repo.ListStoryRevisions(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("ListStoryRevisions rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) GetStoryRevision(ctx context.Context, req *pb.GetStoryRevisionReq) (*pb.StoryRevision, error) {
+	u.Log.Info("GetStoryRevision rpc method started")
+	res, err := u.Repo.GetStoryRevision(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("GetStoryRevision rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) DiffStoryRevisions(ctx context.Context, req *pb.DiffStoryRevisionsReq) (*pb.DiffStoryRevisionsRes, error) {
+	u.Log.Info("DiffStoryRevisions rpc method started")
+	res, err := u.Repo.DiffStoryRevisions(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("DiffStoryRevisions rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) RevertStory(ctx context.Context, req *pb.RevertStoryReq) (*pb.UpdateStoriesRes, error) {
+	u.Log.Info("RevertStory rpc method started")
+	res, mentions, err := u.Repo.RevertStory(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	if err := publishMentions(ctx, u.Events, mentions); err != nil {
+		u.Log.Error(err.Error())
+	}
+	u.Log.Info("RevertStory rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) SetStoryMedia(ctx context.Context, req *pb.SetStoryMediaReq) (*pb.StoryMediaRes, error) {
+	u.Log.Info("SetStoryMedia rpc method started")
+	res, err := u.Repo.SetStoryMedia(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("SetStoryMedia rpc method finished")
+	return res, nil
+}
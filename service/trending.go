@@ -0,0 +1,113 @@
+package service
+
+import (
+	pb "content/genproto/story"
+	"content/pkg/trending"
+	"content/storage/postgres"
+	"context"
+	"time"
+)
+
+func (u *StoryService) GetTrendingStories(ctx context.Context, req *pb.GetTrendingStoriesReq) (*pb.GetAllStoriesRes, error) {
+	u.Log.Info("GetTrendingStories rpc method started")
+	res, err := u.getTrendingStories(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("GetTrendingStories rpc method finished")
+	return res, nil
+}
+
+func (u *StoryService) getTrendingStories(ctx context.Context, req *pb.GetTrendingStoriesReq) (*pb.GetAllStoriesRes, error) {
+	w, err := trending.ParseWindow(req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := u.Trending.Ranking(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return &pb.GetAllStoriesRes{Offset: req.Offset, Limit: req.Limit}, nil
+	}
+
+	return u.Repo.GetTrendingStories(ctx, ids, time.Now().Add(-w.Span()), req)
+}
+
+func trendingScores(stats postgres.TrendingStats, now time.Time) map[trending.Window]float64 {
+	scores := make(map[trending.Window]float64)
+	for _, w := range trending.Windows {
+		if w.Contains(stats.PublishedAt, now) {
+			scores[w] = trending.Score(w, stats.Likes, stats.Comments, stats.Views, stats.PublishedAt)
+		}
+	}
+	return scores
+}
+
+// refreshTrending rescores a story after its engagement changed. Failures
+// are logged only; the next recompute fixes the rankings.
+func (u *StoryService) refreshTrending(ctx context.Context, storyID string) {
+	stats, err := u.Repo.StoryTrendingStats(ctx, storyID)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return
+	}
+
+	scores := map[trending.Window]float64{}
+	if stats != nil {
+		scores = trendingScores(*stats, time.Now())
+	}
+	if err := u.Trending.Set(ctx, storyID, scores); err != nil {
+		u.Log.Error(err.Error())
+	}
+}
+
+// RunTrendingRecompute rebuilds the trending rankings from the database right
+// away and then every interval until ctx is cancelled. Rebuilding drops
+// stories that aged out of a window and picks up engagement that was not
+// scored on the spot, such as views.
+func (u *StoryService) RunTrendingRecompute(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.recomputeTrending(ctx); err != nil {
+			u.Log.Error(err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (u *StoryService) recomputeTrending(ctx context.Context) error {
+	now := time.Now()
+	longest := trending.Windows[len(trending.Windows)-1]
+
+	stats, err := u.Repo.TrendingStats(ctx, now.Add(-longest.Span()))
+	if err != nil {
+		return err
+	}
+
+	rankings := make(map[trending.Window]map[string]float64)
+	for _, w := range trending.Windows {
+		rankings[w] = make(map[string]float64)
+	}
+	for _, s := range stats {
+		for w, score := range trendingScores(s, now) {
+			rankings[w][s.StoryId] = score
+		}
+	}
+
+	for _, w := range trending.Windows {
+		if err := u.Trending.Replace(ctx, w, rankings[w]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	pb "content/genproto/story"
+	"context"
+	"fmt"
+	"time"
+)
+
+func (u *StoryService) RecordView(ctx context.Context, req *pb.RecordViewReq) (*pb.RecordViewRes, error) {
+	u.Log.Info("RecordView rpc method started")
+	res, err := u.recordView(ctx, req)
+	if err != nil {
+		u.Log.Error(err.Error())
+		return nil, err
+	}
+	u.Log.Info("RecordView rpc method finished")
+	return res, nil
+}
+
+// recordView counts a view once per viewer and window. Signed in viewers are
+// told apart by user id, anonymous ones by their session.
+func (u *StoryService) recordView(ctx context.Context, req *pb.RecordViewReq) (*pb.RecordViewRes, error) {
+	viewer := "u:" + req.UserId
+	if req.UserId == "" {
+		if req.SessionId == "" {
+			return nil, fmt.Errorf("user_id or session_id is required")
+		}
+		viewer = "s:" + req.SessionId
+	}
+
+	if err := u.Repo.StoryViewable(ctx, req.StoryId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	counted, err := u.Views.Record(ctx, req.StoryId, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RecordViewRes{StoryId: req.StoryId, Counted: counted}, nil
+}
+
+// RunViewsFlush writes the views counted in redis to stories.views_count
+// every interval until ctx is cancelled.
+func (u *StoryService) RunViewsFlush(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := u.flushViews(ctx); err != nil {
+				u.Log.Error(err.Error())
+			}
+		}
+	}
+}
+
+func (u *StoryService) flushViews(ctx context.Context) error {
+	pending, err := u.Views.TakePending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending.Counts) > 0 {
+		if err := u.Repo.AddStoryViews(ctx, pending.Counts); err != nil {
+			return err
+		}
+	}
+	return u.Views.Flushed(ctx, pending)
+}
@@ -0,0 +1,68 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(t.TempDir(), "http://cdn.example.com/media/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := store.Put(ctx, "ab/photo.jpg", strings.NewReader("jpeg bytes"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 10 {
+		t.Errorf("Put wrote %d bytes, want 10", n)
+	}
+
+	r, err := store.Get(ctx, "ab/photo.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "jpeg bytes" {
+		t.Errorf("Get returned %q", data)
+	}
+
+	if got := store.URL("ab/photo.jpg"); got != "http://cdn.example.com/media/ab/photo.jpg" {
+		t.Errorf("URL = %q", got)
+	}
+
+	if err := store.Delete(ctx, "ab/photo.jpg"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(ctx, "ab/photo.jpg"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete returned %v, want ErrNotFound", err)
+	}
+	if err := store.Delete(ctx, "ab/photo.jpg"); err != nil {
+		t.Errorf("Delete of missing key returned %v", err)
+	}
+}
+
+func TestLocalStoreKeyStaysInRoot(t *testing.T) {
+	store := &LocalStore{Root: "/srv/media"}
+
+	name, err := store.path("../../etc/passwd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "/srv/media/etc/passwd" {
+		t.Errorf("path escaped root: %q", name)
+	}
+
+	if _, err := store.path(".."); err == nil {
+		t.Error("expected an error for a key without a file name")
+	}
+}
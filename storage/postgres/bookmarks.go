@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	pb "content/genproto/content"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+const (
+	BookmarkTypeStory     = "story"
+	BookmarkTypeItinerary = "itinerary"
+	BookmarkTypeTip       = "tip"
+)
+
+func validateBookmarkType(entityType string) error {
+	switch entityType {
+	case BookmarkTypeStory, BookmarkTypeItinerary, BookmarkTypeTip:
+		return nil
+	}
+	return fmt.Errorf("invalid bookmark entity type %q", entityType)
+}
+
+// bookmarkable lists every live entity that can be bookmarked with its
+// title, author and visibility. Callers filter it with visibleTo.
+const bookmarkable = `
+        SELECT 'story' AS type, id, title, author_id, visibility
+        FROM stories
+        WHERE deleted_at = 0 AND status = 'published' AND is_hidden = false
+        UNION ALL
+        SELECT 'itinerary', id, title, author_id, visibility
+        FROM itineraries
+        WHERE deleted_at = 0
+        UNION ALL
+        SELECT 'tip', id, title, author_id, 'public'
+        FROM travel_tips
+        WHERE is_hidden = false
+`
+
+// AddBookmark saves a story, itinerary or tip for the user. Bookmarking the
+// same entity twice keeps the first bookmark.
+func (c *ContentRepo) AddBookmark(ctx context.Context, req *pb.BookmarkReq) (*pb.Bookmark, error) {
+	if err := validateBookmarkType(req.EntityType); err != nil {
+		return nil, err
+	}
+
+	_, err := c.DB.ExecContext(ctx, `
+        INSERT INTO bookmarks (user_id, entity_type, entity_id)
+        SELECT $1, e.type, e.id
+        FROM (`+bookmarkable+`) e
+        WHERE e.type = $2 AND e.id::text = $3 AND `+visibleTo("e", "$1::text")+`
+        ON CONFLICT (user_id, entity_type, entity_id) DO NOTHING
+    `, req.UserId, req.EntityType, req.EntityId)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT b.entity_type, b.entity_id, e.title, b.created_at,
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, '')
+        FROM bookmarks b
+        JOIN (` + bookmarkable + `) e ON e.type = b.entity_type AND e.id = b.entity_id
+        LEFT JOIN users u ON e.author_id = u.id
+        WHERE b.user_id = $1 AND b.entity_type = $2 AND b.entity_id::text = $3
+          AND ` + visibleTo("e", "$1::text") + `
+    `
+	bookmark, err := scanBookmark(c.DB.QueryRowContext(ctx, query, req.UserId, req.EntityType, req.EntityId))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s %s not found", req.EntityType, req.EntityId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return bookmark, nil
+}
+
+func (c *ContentRepo) RemoveBookmark(ctx context.Context, req *pb.BookmarkReq) error {
+	if err := validateBookmarkType(req.EntityType); err != nil {
+		return err
+	}
+
+	_, err := c.DB.ExecContext(ctx, `
+        DELETE FROM bookmarks WHERE user_id = $1 AND entity_type = $2 AND entity_id::text = $3
+    `, req.UserId, req.EntityType, req.EntityId)
+	return err
+}
+
+// ListBookmarks lists the user's bookmarks, newest first, optionally of one
+// entity type only. Bookmarks of content that is no longer visible are
+// skipped.
+func (c *ContentRepo) ListBookmarks(ctx context.Context, req *pb.ListBookmarksReq) (*pb.ListBookmarksRes, error) {
+	if req.EntityType != "" {
+		if err := validateBookmarkType(req.EntityType); err != nil {
+			return nil, err
+		}
+	}
+
+	from := `
+        FROM bookmarks b
+        JOIN (` + bookmarkable + `) e ON e.type = b.entity_type AND e.id = b.entity_id
+        LEFT JOIN users u ON e.author_id = u.id
+        WHERE b.user_id = $1 AND ($2 = '' OR b.entity_type = $2) AND ` + visibleTo("e", "$1::text") + `
+    `
+
+	var total int64
+	err := c.DB.QueryRowContext(ctx, "SELECT COUNT(*)"+from, req.UserId, req.EntityType).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT b.entity_type, b.entity_id, e.title, b.created_at,
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, '')` + from + `
+        ORDER BY b.created_at DESC, b.entity_id
+        LIMIT $3 OFFSET $4
+    `
+	rows, err := c.DB.QueryContext(ctx, query, req.UserId, req.EntityType, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bookmarks []*pb.Bookmark
+	for rows.Next() {
+		bookmark, err := scanBookmark(rows)
+		if err != nil {
+			return nil, err
+		}
+		bookmarks = append(bookmarks, bookmark)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ListBookmarksRes{
+		Bookmarks: bookmarks,
+		Total:     total,
+		Offset:    req.Offset,
+		Limit:     req.Limit,
+	}, nil
+}
+
+func scanBookmark(row interface{ Scan(...interface{}) error }) (*pb.Bookmark, error) {
+	var bookmark pb.Bookmark
+	var author pb.Author
+
+	err := row.Scan(
+		&bookmark.EntityType,
+		&bookmark.EntityId,
+		&bookmark.Title,
+		&bookmark.CreatedAt,
+		&author.UserId,
+		&author.Username,
+		&author.FullName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmark.Author = &author
+	return &bookmark, nil
+}
+
+// bookmarkedIDs returns which of the given entities the user bookmarked.
+func bookmarkedIDs(ctx context.Context, db *sql.DB, userID, entityType string, ids []string) (map[string]bool, error) {
+	bookmarked := make(map[string]bool)
+	if userID == "" || len(ids) == 0 {
+		return bookmarked, nil
+	}
+
+	rows, err := db.QueryContext(ctx, `
+        SELECT entity_id FROM bookmarks
+        WHERE user_id = $1 AND entity_type = $2 AND entity_id::text = ANY($3)
+    `, userID, entityType, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		bookmarked[id] = true
+	}
+
+	return bookmarked, rows.Err()
+}
+
+// deleteBookmarks removes the bookmarks of an entity that was deleted.
+func deleteBookmarks(ctx context.Context, tx *sql.Tx, entityType, entityID string) error {
+	_, err := tx.ExecContext(ctx, `
+        DELETE FROM bookmarks WHERE entity_type = $1 AND entity_id::text = $2
+    `, entityType, entityID)
+	return err
+}
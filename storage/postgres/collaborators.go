@@ -0,0 +1,286 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+const (
+	CollaboratorOwner  = "owner"
+	CollaboratorEditor = "editor"
+)
+
+const (
+	CollaborationPending  = "pending"
+	CollaborationAccepted = "accepted"
+)
+
+func validateCollaboratorRole(role string) error {
+	switch role {
+	case CollaboratorOwner, CollaboratorEditor:
+		return nil
+	}
+	return fmt.Errorf("invalid collaborator role %q", role)
+}
+
+// storyAuthoredBy returns the SQL condition under which user, an SQL
+// expression holding a user id, is one of the authors of the story aliased
+// alias: its creator or a collaborator who accepted the invitation.
+func storyAuthoredBy(alias, user string) string {
+	return fmt.Sprintf(`(%[1]s.author_id = NULLIF(%[2]s, '')::uuid OR EXISTS (
+            SELECT 1 FROM story_collaborators sc
+            WHERE sc.story_id = %[1]s.id AND sc.user_id = NULLIF(%[2]s, '')::uuid AND sc.status = 'accepted'))`, alias, user)
+}
+
+// storyRole returns the role userID has on a live story: owner for its
+// creator, the collaborator role once an invitation was accepted, and the
+// empty string for everybody else.
+func storyRole(ctx context.Context, q rowQueryer, storyID, userID string) (string, error) {
+	var role string
+	err := q.QueryRowContext(ctx, `
+        SELECT CASE WHEN s.author_id = NULLIF($2, '')::uuid THEN 'owner'
+                    ELSE COALESCE((SELECT sc.role FROM story_collaborators sc
+                                   WHERE sc.story_id = s.id AND sc.user_id = NULLIF($2, '')::uuid
+                                     AND sc.status = 'accepted'), '')
+               END
+        FROM stories s
+        WHERE s.id = $1 AND s.deleted_at = 0
+    `, storyID, userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("story %s not found", storyID)
+	}
+	return role, err
+}
+
+const collaboratorColumns = `
+        sc.story_id, sc.user_id, COALESCE(u.username, ''), COALESCE(u.full_name, ''),
+        sc.role, sc.status, COALESCE(sc.invited_by::text, ''), sc.created_at, COALESCE(sc.accepted_at::text, '')
+`
+
+func scanCollaborator(row interface{ Scan(...interface{}) error }) (*pb.StoryCollaborator, error) {
+	var collaborator pb.StoryCollaborator
+	var user pb.Author
+
+	err := row.Scan(
+		&collaborator.StoryId,
+		&user.UserId,
+		&user.Username,
+		&user.FullName,
+		&collaborator.Role,
+		&collaborator.Status,
+		&collaborator.InvitedBy,
+		&collaborator.CreatedAt,
+		&collaborator.AcceptedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborator.User = &user
+	return &collaborator, nil
+}
+
+func (c *StoryRepo) getCollaborator(ctx context.Context, q rowQueryer, storyID, userID string) (*pb.StoryCollaborator, error) {
+	query := `
+        SELECT ` + collaboratorColumns + `
+        FROM story_collaborators sc
+        LEFT JOIN users u ON sc.user_id = u.id
+        WHERE sc.story_id = $1 AND sc.user_id = $2
+    `
+	return scanCollaborator(q.QueryRowContext(ctx, query, storyID, userID))
+}
+
+// InviteCollaborator invites a user to co-author a story. Only owners can
+// invite. Inviting a user again changes their role and keeps an accepted
+// invitation accepted.
+func (c *StoryRepo) InviteCollaborator(ctx context.Context, req *pb.InviteCollaboratorReq) (*pb.StoryCollaborator, error) {
+	if err := validateCollaboratorRole(req.Role); err != nil {
+		return nil, err
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	role, err := storyRole(ctx, tx, req.StoryId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if role != CollaboratorOwner {
+		return nil, fmt.Errorf("user %s may not invite collaborators to story %s", req.UserId, req.StoryId)
+	}
+
+	query := `
+        INSERT INTO story_collaborators (story_id, user_id, role, invited_by)
+        SELECT s.id, $2, $3, $4
+        FROM stories s
+        WHERE s.id = $1 AND s.author_id IS DISTINCT FROM $2::uuid
+        ON CONFLICT (story_id, user_id) DO UPDATE SET role = EXCLUDED.role, invited_by = EXCLUDED.invited_by
+    `
+	invited, err := execCount(ctx, tx, query, req.StoryId, req.InviteeId, req.Role, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if invited == 0 {
+		return nil, fmt.Errorf("user %s created story %s and stays its owner", req.InviteeId, req.StoryId)
+	}
+
+	collaborator, err := c.getCollaborator(ctx, tx, req.StoryId, req.InviteeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return collaborator, nil
+}
+
+// AcceptCollaboration accepts the caller's pending invitation to a story.
+func (c *StoryRepo) AcceptCollaboration(ctx context.Context, req *pb.CollaborationReq) (*pb.StoryCollaborator, error) {
+	query := `
+        UPDATE story_collaborators sc
+        SET status = 'accepted', accepted_at = CURRENT_TIMESTAMP
+        FROM stories s
+        WHERE sc.story_id = s.id AND s.deleted_at = 0
+          AND sc.story_id = $1 AND sc.user_id = NULLIF($2, '')::uuid AND sc.status = 'pending'
+    `
+	accepted, err := c.DB.ExecContext(ctx, query, req.StoryId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	n, err := accepted.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("no pending invitation to story %s for user %s", req.StoryId, req.UserId)
+	}
+
+	return c.getCollaborator(ctx, c.DB, req.StoryId, req.UserId)
+}
+
+// RemoveCollaborator removes a collaborator or withdraws an invitation.
+// Owners can remove anybody; other users can only decline their own
+// invitation or leave the story.
+func (c *StoryRepo) RemoveCollaborator(ctx context.Context, req *pb.RemoveCollaboratorReq) error {
+	if req.CollaboratorId != req.UserId {
+		role, err := storyRole(ctx, c.DB, req.StoryId, req.UserId)
+		if err != nil {
+			return err
+		}
+		if role != CollaboratorOwner {
+			return fmt.Errorf("user %s may not remove collaborators from story %s", req.UserId, req.StoryId)
+		}
+	}
+
+	removed, err := c.DB.ExecContext(ctx, `
+        DELETE FROM story_collaborators WHERE story_id = $1 AND user_id = NULLIF($2, '')::uuid
+    `, req.StoryId, req.CollaboratorId)
+	if err != nil {
+		return err
+	}
+	n, err := removed.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("user %s is not a collaborator of story %s", req.CollaboratorId, req.StoryId)
+	}
+
+	return nil
+}
+
+// ListCollaborators lists the collaborators of a story the caller can see.
+// Pending invitations are only listed to the story's owners.
+func (c *StoryRepo) ListCollaborators(ctx context.Context, req *pb.CollaborationReq) (*pb.ListCollaboratorsRes, error) {
+	if err := storyViewable(ctx, c.DB, req.StoryId, req.UserId); err != nil {
+		return nil, err
+	}
+	role, err := storyRole(ctx, c.DB, req.StoryId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT ` + collaboratorColumns + `
+        FROM story_collaborators sc
+        LEFT JOIN users u ON sc.user_id = u.id
+        WHERE sc.story_id = $1 AND (sc.status = 'accepted' OR $2)
+        ORDER BY sc.accepted_at NULLS LAST, sc.created_at
+    `
+	rows, err := c.DB.QueryContext(ctx, query, req.StoryId, role == CollaboratorOwner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := &pb.ListCollaboratorsRes{}
+	for rows.Next() {
+		collaborator, err := scanCollaborator(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Collaborators = append(res.Collaborators, collaborator)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// storyCoAuthors loads the accepted collaborators of the given stories,
+// keyed by story id, in the order they joined.
+func (c *StoryRepo) storyCoAuthors(ctx context.Context, storyIDs []string) (map[string][]*pb.Author, error) {
+	coAuthors := make(map[string][]*pb.Author)
+	if len(storyIDs) == 0 {
+		return coAuthors, nil
+	}
+
+	rows, err := c.DB.QueryContext(ctx, `
+        SELECT sc.story_id, u.id, u.username, u.full_name
+        FROM story_collaborators sc
+        JOIN users u ON sc.user_id = u.id
+        WHERE sc.story_id = ANY($1) AND sc.status = 'accepted'
+        ORDER BY sc.accepted_at
+    `, pq.Array(storyIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var storyID string
+		var author pb.Author
+		if err := rows.Scan(&storyID, &author.UserId, &author.Username, &author.FullName); err != nil {
+			return nil, err
+		}
+		coAuthors[storyID] = append(coAuthors[storyID], &author)
+	}
+
+	return coAuthors, rows.Err()
+}
+
+func (c *StoryRepo) attachCoAuthors(ctx context.Context, stories []*pb.Stories) error {
+	ids := make([]string, len(stories))
+	for i, story := range stories {
+		ids[i] = story.StoryId
+	}
+
+	coAuthors, err := c.storyCoAuthors(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, story := range stories {
+		story.CoAuthors = coAuthors[story.StoryId]
+	}
+	return nil
+}
@@ -0,0 +1,557 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+const (
+	CollectionPublic   = "public"
+	CollectionUnlisted = "unlisted"
+	CollectionPrivate  = "private"
+)
+
+// MaxCollectionStories caps how many stories one collection can hold.
+const MaxCollectionStories = 500
+
+func validateCollectionVisibility(visibility string) error {
+	switch visibility {
+	case CollectionPublic, CollectionUnlisted, CollectionPrivate:
+		return nil
+	}
+	return fmt.Errorf("invalid collection visibility %q", visibility)
+}
+
+// collectionColumns selects a collection with its owner. stories_count only
+// counts the members that are live, published and open to everybody.
+const collectionColumns = `
+        c.id, COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, ''),
+        c.title, c.description, COALESCE(c.cover_media_id::text, ''), c.visibility,
+        (SELECT COUNT(*) FROM collection_stories cs
+         JOIN stories s ON cs.story_id = s.id
+         WHERE cs.collection_id = c.id AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+           AND s.visibility IN ('public', 'unlisted')),
+        c.created_at, c.updated_at
+`
+
+func scanCollection(row interface{ Scan(...interface{}) error }) (*pb.Collection, string, error) {
+	var collection pb.Collection
+	var owner pb.Author
+	var coverID string
+
+	err := row.Scan(
+		&collection.Id,
+		&owner.UserId,
+		&owner.Username,
+		&owner.FullName,
+		&collection.Title,
+		&collection.Description,
+		&coverID,
+		&collection.Visibility,
+		&collection.StoriesCount,
+		&collection.CreatedAt,
+		&collection.UpdatedAt,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	collection.Owner = &owner
+	return &collection, coverID, nil
+}
+
+// collectionCover loads the cover image of a collection. A cover that was
+// deleted since is left out.
+func (c *StoryRepo) collectionCover(ctx context.Context, mediaID string) (*pb.Media, error) {
+	if mediaID == "" {
+		return nil, nil
+	}
+
+	query := `
+        SELECT ` + mediaColumns + `
+        FROM media m
+        WHERE m.id = $1 AND m.deleted_at = 0
+    `
+	m, err := scanStoryMedia(c.DB.QueryRowContext(ctx, query, mediaID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return m, err
+}
+
+// getCollection loads a live collection. Private collections are only
+// returned to their owner.
+func (c *StoryRepo) getCollection(ctx context.Context, collectionID, userID string) (*pb.Collection, error) {
+	query := `
+        SELECT ` + collectionColumns + `
+        FROM collections c
+        LEFT JOIN users u ON c.owner_id = u.id
+        WHERE c.id = $1 AND c.deleted_at = 0
+          AND (c.visibility <> 'private' OR c.owner_id = NULLIF($2, '')::uuid)
+    `
+	collection, coverID, err := scanCollection(c.DB.QueryRowContext(ctx, query, collectionID, userID))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("collection %s not found", collectionID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	collection.Cover, err = c.collectionCover(ctx, coverID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+// lockCollection locks a live collection for a change by its owner.
+func lockCollection(ctx context.Context, tx *sql.Tx, collectionID, userID string) error {
+	var isOwner bool
+	err := tx.QueryRowContext(ctx, `
+        SELECT COALESCE(owner_id = NULLIF($2, '')::uuid, false)
+        FROM collections WHERE id = $1 AND deleted_at = 0 FOR UPDATE
+    `, collectionID, userID).Scan(&isOwner)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("collection %s not found", collectionID)
+	}
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return fmt.Errorf("user %s is not the owner of collection %s", userID, collectionID)
+	}
+	return nil
+}
+
+// checkCover makes sure a cover image was uploaded by the collection owner.
+func checkCover(ctx context.Context, tx *sql.Tx, mediaID, userID string) error {
+	if mediaID == "" {
+		return nil
+	}
+
+	var owned bool
+	err := tx.QueryRowContext(ctx, `
+        SELECT EXISTS (SELECT 1 FROM media WHERE id::text = $1 AND owner_id = $2 AND deleted_at = 0)
+    `, mediaID, userID).Scan(&owned)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		return fmt.Errorf("media %s not found or not uploaded by user %s", mediaID, userID)
+	}
+	return nil
+}
+
+func (c *StoryRepo) CreateCollection(ctx context.Context, req *pb.CreateCollectionReq) (*pb.Collection, error) {
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = CollectionPublic
+	}
+	if err := validateCollectionVisibility(visibility); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Title) == "" {
+		return nil, fmt.Errorf("collection title is required")
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := checkCover(ctx, tx, req.CoverMediaId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	var id string
+	err = tx.QueryRowContext(ctx, `
+        INSERT INTO collections (owner_id, title, description, cover_media_id, visibility)
+        VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5)
+        RETURNING id
+    `, req.UserId, req.Title, req.Description, req.CoverMediaId, visibility).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return c.getCollection(ctx, id, req.UserId)
+}
+
+// UpdateCollection replaces the title, description and cover of a
+// collection. An empty CoverMediaId removes the cover and an empty
+// Visibility keeps the current one.
+func (c *StoryRepo) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionReq) (*pb.Collection, error) {
+	if req.Visibility != "" {
+		if err := validateCollectionVisibility(req.Visibility); err != nil {
+			return nil, err
+		}
+	}
+	if strings.TrimSpace(req.Title) == "" {
+		return nil, fmt.Errorf("collection title is required")
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockCollection(ctx, tx, req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+	if err := checkCover(ctx, tx, req.CoverMediaId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        UPDATE collections
+        SET title = $2, description = $3, cover_media_id = NULLIF($4, '')::uuid,
+            visibility = COALESCE(NULLIF($5, ''), visibility), updated_at = CURRENT_TIMESTAMP
+        WHERE id = $1
+    `, req.Id, req.Title, req.Description, req.CoverMediaId, req.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return c.getCollection(ctx, req.Id, req.UserId)
+}
+
+func (c *StoryRepo) DeleteCollection(ctx context.Context, req *pb.CollectionReq) error {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := lockCollection(ctx, tx, req.Id, req.UserId); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        UPDATE collections
+        SET deleted_at = date_part('epoch', current_timestamp)::INT
+        WHERE id = $1
+    `, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListCollections lists the collections of one owner, newest first. Other
+// users only see the public ones.
+func (c *StoryRepo) ListCollections(ctx context.Context, req *pb.ListCollectionsReq) (*pb.ListCollectionsRes, error) {
+	from := `
+        FROM collections c
+        LEFT JOIN users u ON c.owner_id = u.id
+        WHERE c.owner_id = $1 AND c.deleted_at = 0
+          AND (c.visibility = 'public' OR c.owner_id = NULLIF($2, '')::uuid)
+    `
+
+	var total int64
+	err := c.DB.QueryRowContext(ctx, "SELECT COUNT(*)"+from, req.OwnerId, req.UserId).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + collectionColumns + from + `
+        ORDER BY c.created_at DESC
+        LIMIT $3 OFFSET $4
+    `
+	rows, err := c.DB.QueryContext(ctx, query, req.OwnerId, req.UserId, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var collections []*pb.Collection
+	var covers []string
+	for rows.Next() {
+		collection, coverID, err := scanCollection(rows)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, collection)
+		covers = append(covers, coverID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, collection := range collections {
+		collection.Cover, err = c.collectionCover(ctx, covers[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListCollectionsRes{
+		Collections: collections,
+		Total:       total,
+		Offset:      req.Offset,
+		Limit:       req.Limit,
+	}, nil
+}
+
+// collectionStories lists the members of collection $1 that viewer $2 may
+// open.
+var collectionStories = `
+        FROM collection_stories cs
+        JOIN stories s ON cs.story_id = s.id
+        LEFT JOIN users u ON s.author_id = u.id
+        WHERE cs.collection_id = $1 AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+          AND ` + storyVisibleTo("s", "$2") + `
+`
+
+// GetCollection returns a collection with a page of its stories in
+// collection order. Only live, published stories the caller may open are
+// listed.
+func (c *StoryRepo) GetCollection(ctx context.Context, req *pb.GetCollectionReq) (*pb.GetCollectionRes, error) {
+	collection, err := c.getCollection(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT s.id, s.title, s.location, s.likes_count, s.comments_count, COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, ''),
+               EXISTS (SELECT 1 FROM likes l WHERE l.story_id = s.id AND l.user_id = NULLIF($2, '')::uuid), s.status,
+               s.latitude, s.longitude, s.place_name, s.views_count,
+               EXISTS (SELECT 1 FROM bookmarks b WHERE b.entity_type = 'story' AND b.entity_id = s.id AND b.user_id = NULLIF($2, '')::uuid),
+               s.visibility` + collectionStories + `
+        ORDER BY cs.position
+        LIMIT $3 OFFSET $4
+    `
+	rows, err := c.DB.QueryContext(ctx, query, req.Id, req.UserId, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stories []*pb.Stories
+	for rows.Next() {
+		var story pb.Stories
+		var author pb.Author
+		var point geoColumns
+
+		err := rows.Scan(
+			&story.StoryId,
+			&story.Title,
+			&story.Location,
+			&story.LikesCount,
+			&story.CommentsCount,
+			&author.UserId,
+			&author.Username,
+			&author.FullName,
+			&story.Liked,
+			&story.Status,
+			&point.Latitude,
+			&point.Longitude,
+			&point.PlaceName,
+			&story.ViewsCount,
+			&story.IsBookmarked,
+			&story.Visibility,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		story.Author = &author
+		story.Geo = storyGeoPoint(point)
+		stories = append(stories, &story)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := c.attachCoAuthors(ctx, stories); err != nil {
+		return nil, err
+	}
+
+	var total int64
+	err = c.DB.QueryRowContext(ctx, "SELECT COUNT(*)"+collectionStories, req.Id, req.UserId).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCollectionRes{
+		Collection: collection,
+		Stories:    stories,
+		Total:      total,
+		Offset:     req.Offset,
+		Limit:      req.Limit,
+	}, nil
+}
+
+// AddCollectionStory appends a published story to the end of a collection.
+// Adding a story that is already a member leaves it where it is.
+func (c *StoryRepo) AddCollectionStory(ctx context.Context, req *pb.CollectionStoryReq) (*pb.Collection, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockCollection(ctx, tx, req.CollectionId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	var published bool
+	err = tx.QueryRowContext(ctx, `
+        SELECT EXISTS (
+            SELECT 1 FROM stories s
+            WHERE s.id::text = $1 AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+              AND `+storyVisibleTo("s", "$2")+`
+        )
+    `, req.StoryId, req.UserId).Scan(&published)
+	if err != nil {
+		return nil, err
+	}
+	if !published {
+		return nil, fmt.Errorf("story %s not found", req.StoryId)
+	}
+
+	var members int
+	err = tx.QueryRowContext(ctx, `
+        SELECT COUNT(*) FROM collection_stories WHERE collection_id = $1
+    `, req.CollectionId).Scan(&members)
+	if err != nil {
+		return nil, err
+	}
+	if members >= MaxCollectionStories {
+		return nil, fmt.Errorf("a collection can hold at most %d stories", MaxCollectionStories)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO collection_stories (collection_id, story_id, position)
+        SELECT $1, $2, COALESCE(MAX(position) + 1, 0) FROM collection_stories WHERE collection_id = $1
+        ON CONFLICT (collection_id, story_id) DO NOTHING
+    `, req.CollectionId, req.StoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := touchCollection(ctx, tx, req.CollectionId); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return c.getCollection(ctx, req.CollectionId, req.UserId)
+}
+
+func (c *StoryRepo) RemoveCollectionStory(ctx context.Context, req *pb.CollectionStoryReq) (*pb.Collection, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockCollection(ctx, tx, req.CollectionId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        DELETE FROM collection_stories WHERE collection_id = $1 AND story_id::text = $2
+    `, req.CollectionId, req.StoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := touchCollection(ctx, tx, req.CollectionId); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return c.getCollection(ctx, req.CollectionId, req.UserId)
+}
+
+// ReorderCollection moves the listed stories to the front of the collection
+// in the given order. Members that are not listed, such as stories that were
+// unpublished meanwhile, keep their relative order after them.
+func (c *StoryRepo) ReorderCollection(ctx context.Context, req *pb.ReorderCollectionReq) (*pb.Collection, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockCollection(ctx, tx, req.CollectionId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	members, err := queryIDs(ctx, tx, `
+        SELECT story_id FROM collection_stories WHERE collection_id = $1 ORDER BY position
+    `, req.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember := make(map[string]bool, len(members))
+	for _, id := range members {
+		isMember[id] = true
+	}
+	listed := make(map[string]bool, len(req.StoryIds))
+	for _, id := range req.StoryIds {
+		if !isMember[id] {
+			return nil, fmt.Errorf("story %s is not in collection %s", id, req.CollectionId)
+		}
+		if listed[id] {
+			return nil, fmt.Errorf("story %s is listed twice", id)
+		}
+		listed[id] = true
+	}
+
+	order := append([]string{}, req.StoryIds...)
+	for _, id := range members {
+		if !listed[id] {
+			order = append(order, id)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        UPDATE collection_stories cs
+        SET position = o.position - 1
+        FROM unnest($2::uuid[]) WITH ORDINALITY AS o(story_id, position)
+        WHERE cs.collection_id = $1 AND cs.story_id = o.story_id
+    `, req.CollectionId, pq.Array(order))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := touchCollection(ctx, tx, req.CollectionId); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return c.getCollection(ctx, req.CollectionId, req.UserId)
+}
+
+func touchCollection(ctx context.Context, tx *sql.Tx, collectionID string) error {
+	_, err := tx.ExecContext(ctx, `UPDATE collections SET updated_at = CURRENT_TIMESTAMP WHERE id = $1`, collectionID)
+	return err
+}
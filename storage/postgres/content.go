@@ -19,7 +19,7 @@ func NewContentRepository(db *sql.DB) *ContentRepo {
 func (c *ContentRepo) GetDestinations(ctx context.Context, req *pb.GetDestinationsReq) (*pb.GetDestinationsRes, error) {
 
 	query := `
-        SELECT id, name, country, description, currency
+        SELECT id, name, country, description, currency, latitude, longitude, place_name
         FROM destinations
         WHERE ($1 = '' OR name ILIKE '%' || $1 || '%')
         ORDER BY name
@@ -35,15 +35,20 @@ func (c *ContentRepo) GetDestinations(ctx context.Context, req *pb.GetDestinatio
 	var destinations []*pb.Destinations
 	for rows.Next() {
 		var destination pb.Destinations
+		var point geoColumns
 		if err := rows.Scan(
 			&destination.Id,
 			&destination.Name,
 			&destination.Country,
 			&destination.Description,
 			&destination.Currency,
+			&point.Latitude,
+			&point.Longitude,
+			&point.PlaceName,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan destination row: %v", err)
 		}
+		destination.Geo = contentGeoPoint(point)
 		destinations = append(destinations, &destination)
 	}
 
@@ -71,12 +76,14 @@ func (c *ContentRepo) GetDestinations(ctx context.Context, req *pb.GetDestinatio
 func (c *ContentRepo) GetDestinationsById(ctx context.Context, req *pb.GetDestinationsByIdReq) (*pb.GetDestinationsByIdRes, error) {
 
 	query := `
-        SELECT id, name, country, description, best_time_to_visit, average_cost_per_day, currency, language
+        SELECT id, name, country, description, best_time_to_visit, average_cost_per_day, currency, language,
+               latitude, longitude, place_name
         FROM destinations
         WHERE id = $1
     `
 
 	var destination pb.GetDestinationsByIdRes
+	var point geoColumns
 	err := c.DB.QueryRowContext(ctx, query, req.Id).Scan(
 		&destination.Id,
 		&destination.Name,
@@ -86,24 +93,33 @@ func (c *ContentRepo) GetDestinationsById(ctx context.Context, req *pb.GetDestin
 		&destination.AverageCostPerDay,
 		&destination.Currency,
 		&destination.Language,
+		&point.Latitude,
+		&point.Longitude,
+		&point.PlaceName,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch destination by ID: %v", err)
 	}
+	destination.Geo = contentGeoPoint(point)
 
 	return &destination, nil
 }
 
-func (c *ContentRepo) SendMessage(ctx context.Context, req *pb.SendMessageReq) (*pb.SendMessageRes, error) {
+func (c *ContentRepo) SendMessage(ctx context.Context, req *pb.SendMessageReq, hold *Hold) (*pb.SendMessageRes, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 
 	query := `
-        INSERT INTO messages (id, sender_id, recipient_id, content, created_at)
-        VALUES (gen_random_uuid(), $1, $2, $3, CURRENT_TIMESTAMP)
+        INSERT INTO messages (id, sender_id, recipient_id, content, created_at, is_hidden)
+        VALUES (gen_random_uuid(), $1, $2, $3, CURRENT_TIMESTAMP, $4)
         RETURNING id, sender_id, recipient_id, content
     `
 
 	var message pb.SendMessageRes
-	err := c.DB.QueryRowContext(ctx, query, req.UserId, req.RecipientId, req.Content).Scan(
+	err = tx.QueryRowContext(ctx, query, req.UserId, req.RecipientId, req.Content, hold != nil).Scan(
 		&message.Id,
 		&message.UserId,
 		&message.RecipientId,
@@ -112,6 +128,13 @@ func (c *ContentRepo) SendMessage(ctx context.Context, req *pb.SendMessageReq) (
 	if err != nil {
 		return nil, fmt.Errorf("failed to send message: %v", err)
 	}
+	if err := fileHold(ctx, tx, hold, ReportTypeMessage, message.Id, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	return &message, nil
 }
@@ -125,6 +148,7 @@ func (c *ContentRepo) GetMessages(ctx context.Context, req *pb.GetMessagesReq) (
 FROM messages m
 INNER JOIN users s ON m.sender_id = s.id
 INNER JOIN users r ON m.recipient_id = r.id
+WHERE m.is_hidden = false
 ORDER BY m.created_at DESC
 LIMIT $1 OFFSET $2
 
@@ -154,7 +178,7 @@ LIMIT $1 OFFSET $2
 		messages = append(messages, &message)
 	}
 
-	countQuery := `SELECT COUNT(*) FROM messages`
+	countQuery := `SELECT COUNT(*) FROM messages WHERE is_hidden = false`
 	var total int64
 	if err := c.DB.QueryRowContext(ctx, countQuery).Scan(&total); err != nil {
 		return nil, fmt.Errorf("failed to fetch total message count: %v", err)
@@ -170,22 +194,35 @@ LIMIT $1 OFFSET $2
 	return res, nil
 }
 
-func (c *ContentRepo) CreateTips(ctx context.Context, req *pb.CreateTipsReq) (*pb.CreateTipsRes, error) {
+func (c *ContentRepo) CreateTips(ctx context.Context, req *pb.CreateTipsReq, hold *Hold) (*pb.CreateTipsRes, error) {
 
 	authorID := req.UserId
 
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	query := `
-        INSERT INTO travel_tips (title, content, category, author_id, created_at)
-        VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)
+        INSERT INTO travel_tips (title, content, category, author_id, created_at, is_hidden)
+        VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP, $5)
         RETURNING id
     `
 
 	var id string
 
-	err := c.DB.QueryRowContext(ctx, query, req.Title, req.Content, req.Category, authorID).Scan(&id)
+	err = tx.QueryRowContext(ctx, query, req.Title, req.Content, req.Category, authorID, hold != nil).Scan(&id)
 	if err != nil {
 		return nil, err
 	}
+	if err := fileHold(ctx, tx, hold, ReportTypeTip, id, authorID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	res := &pb.CreateTipsRes{
 		Id:       id,
@@ -200,13 +237,13 @@ func (c *ContentRepo) CreateTips(ctx context.Context, req *pb.CreateTipsReq) (*p
 
 func (c *ContentRepo) GetTips(ctx context.Context, req *pb.GetTipsReq) (*pb.GetTipsRes, error) {
 	query := `
-        SELECT tt.id, tt.title, tt.category, u.id AS user_id, u.username, u.full_name
+        SELECT tt.id, tt.title, tt.category, COALESCE(u.id::text, '') AS user_id, COALESCE(u.username, ''), COALESCE(u.full_name, '')
         FROM travel_tips tt
-        JOIN users u ON tt.author_id = u.id
+        LEFT JOIN users u ON tt.author_id = u.id
     `
 
 	queryParams := make([]interface{}, 0)
-	conditions := make([]string, 0)
+	conditions := []string{"tt.is_hidden = false"}
 
 	n := 1
 	if req.Category != "" {
@@ -251,6 +288,22 @@ func (c *ContentRepo) GetTips(ctx context.Context, req *pb.GetTipsReq) (*pb.GetT
 		tips = append(tips, tip)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(tips))
+	for i, tip := range tips {
+		ids[i] = tip.Id
+	}
+	bookmarked, err := bookmarkedIDs(ctx, c.DB, req.UserId, BookmarkTypeTip, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, tip := range tips {
+		tip.IsBookmarked = bookmarked[tip.Id]
+	}
+
 	countQuery := `
         SELECT COUNT(*) AS total
         FROM travel_tips tt
@@ -280,31 +333,53 @@ func (c *ContentRepo) GetTips(ctx context.Context, req *pb.GetTipsReq) (*pb.GetT
 	return res, nil
 }
 
+// GetUserStat sums up a user's published content, including the stories the
+// user co-authors. Stories and itineraries are counted only if they are
+// listed to the viewer, so other users do not learn about content they
+// cannot see.
 func (c *ContentRepo) GetUserStat(ctx context.Context, req *pb.GetUserStatReq) (*pb.GetUserStatRes, error) {
 
 	res := &pb.GetUserStatRes{
 		UserId: req.UserId,
 	}
 
+	// Hidden stories only count for their authors.
+	statStories := `s.deleted_at = 0 AND s.status = 'published'
+          AND (s.is_hidden = false OR ` + storyAuthoredBy("s", "$2") + `) AND ` + storyListedTo("s", "$2")
+
 	storyQuery := `
         SELECT COUNT(*) AS total_stories
-        FROM stories
-        WHERE author_id = $1 AND deleted_at = 0
+        FROM stories s
+        WHERE ` + storyAuthoredBy("s", "$1") + ` AND ` + statStories + `
     `
 	var totalStories int64
-	err := c.DB.QueryRowContext(ctx, storyQuery, req.UserId).Scan(&totalStories)
+	err := c.DB.QueryRowContext(ctx, storyQuery, req.UserId, req.ViewerId).Scan(&totalStories)
 	if err != nil {
 		return nil, err
 	}
 	res.TotalStories = fmt.Sprintf("%d", totalStories)
 
+	coAuthoredQuery := `
+        SELECT COUNT(*)
+        FROM stories s
+        JOIN story_collaborators sc ON sc.story_id = s.id
+        WHERE sc.user_id = $1 AND sc.status = 'accepted'
+          AND ` + statStories + `
+    `
+	var coAuthoredStories int64
+	err = c.DB.QueryRowContext(ctx, coAuthoredQuery, req.UserId, req.ViewerId).Scan(&coAuthoredStories)
+	if err != nil {
+		return nil, err
+	}
+	res.TotalCoAuthoredStories = fmt.Sprintf("%d", coAuthoredStories)
+
 	itineraryQuery := `
         SELECT COUNT(*) AS total_itineraries
-        FROM itineraries
-        WHERE author_id = $1 AND deleted_at = 0
+        FROM itineraries i
+        WHERE i.author_id = $1 AND i.deleted_at = 0 AND ` + listedTo("i", "$2") + `
     `
 	var totalItineraries int64
-	err = c.DB.QueryRowContext(ctx, itineraryQuery, req.UserId).Scan(&totalItineraries)
+	err = c.DB.QueryRowContext(ctx, itineraryQuery, req.UserId, req.ViewerId).Scan(&totalItineraries)
 	if err != nil {
 		return nil, err
 	}
@@ -326,16 +401,16 @@ func (c *ContentRepo) GetUserStat(ctx context.Context, req *pb.GetUserStatReq) (
         SELECT SUM(likes_count) AS total_likes_received
         FROM (
             SELECT likes_count
-            FROM stories
-            WHERE author_id = $1 AND deleted_at = 0
+            FROM stories s
+            WHERE ` + storyAuthoredBy("s", "$1") + ` AND ` + statStories + `
             UNION ALL
             SELECT likes_count
-            FROM itineraries
-            WHERE author_id = $1 AND deleted_at = 0
+            FROM itineraries i
+            WHERE i.author_id = NULLIF($1, '')::uuid AND i.deleted_at = 0 AND ` + listedTo("i", "$2") + `
         ) AS combined_likes
     `
 	var totalLikesReceived sql.NullInt64
-	err = c.DB.QueryRowContext(ctx, likesQuery, req.UserId).Scan(&totalLikesReceived)
+	err = c.DB.QueryRowContext(ctx, likesQuery, req.UserId, req.ViewerId).Scan(&totalLikesReceived)
 	if err != nil {
 		return nil, err
 	}
@@ -349,16 +424,16 @@ func (c *ContentRepo) GetUserStat(ctx context.Context, req *pb.GetUserStatReq) (
         SELECT SUM(comments_count) AS total_comments_received
         FROM (
             SELECT comments_count
-            FROM stories
-            WHERE author_id = $1 AND deleted_at = 0
+            FROM stories s
+            WHERE ` + storyAuthoredBy("s", "$1") + ` AND ` + statStories + `
             UNION ALL
             SELECT comments_count
-            FROM itineraries
-            WHERE author_id = $1 AND deleted_at = 0
+            FROM itineraries i
+            WHERE i.author_id = NULLIF($1, '')::uuid AND i.deleted_at = 0 AND ` + listedTo("i", "$2") + `
         ) AS combined_comments
     `
 	var totalCommentsReceived sql.NullInt64
-	err = c.DB.QueryRowContext(ctx, commentsQuery, req.UserId).Scan(&totalCommentsReceived)
+	err = c.DB.QueryRowContext(ctx, commentsQuery, req.UserId, req.ViewerId).Scan(&totalCommentsReceived)
 	if err != nil {
 		return nil, err
 	}
@@ -368,15 +443,27 @@ func (c *ContentRepo) GetUserStat(ctx context.Context, req *pb.GetUserStatReq) (
 		res.TotalCommentsReceived = "0"
 	}
 
+	viewsQuery := `
+        SELECT COALESCE(SUM(views_count), 0)
+        FROM stories s
+        WHERE ` + storyAuthoredBy("s", "$1") + ` AND ` + statStories + `
+    `
+	var totalViewsReceived int64
+	err = c.DB.QueryRowContext(ctx, viewsQuery, req.UserId, req.ViewerId).Scan(&totalViewsReceived)
+	if err != nil {
+		return nil, err
+	}
+	res.TotalViewsReceived = fmt.Sprintf("%d", totalViewsReceived)
+
 	popularStoryQuery := `
         SELECT id, title, likes_count
-        FROM stories
-        WHERE author_id = $1 AND deleted_at = 0
+        FROM stories s
+        WHERE ` + storyAuthoredBy("s", "$1") + ` AND ` + statStories + `
         ORDER BY likes_count DESC
         LIMIT 1
     `
 	var popularStory pb.PopularStory
-	err = c.DB.QueryRowContext(ctx, popularStoryQuery, req.UserId).Scan(&popularStory.Id, &popularStory.Title, &popularStory.LikesCount)
+	err = c.DB.QueryRowContext(ctx, popularStoryQuery, req.UserId, req.ViewerId).Scan(&popularStory.Id, &popularStory.Title, &popularStory.LikesCount)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			popularStory.Id = ""
@@ -390,13 +477,13 @@ func (c *ContentRepo) GetUserStat(ctx context.Context, req *pb.GetUserStatReq) (
 
 	popularItineraryQuery := `
         SELECT id, title, likes_count
-        FROM itineraries
-        WHERE author_id = $1 AND deleted_at = 0
+        FROM itineraries i
+        WHERE i.author_id = $1 AND i.deleted_at = 0 AND ` + listedTo("i", "$2") + `
         ORDER BY likes_count DESC
         LIMIT 1
     `
 	var popularItinerary pb.PopularItinerary
-	err = c.DB.QueryRowContext(ctx, popularItineraryQuery, req.UserId).Scan(&popularItinerary.Id, &popularItinerary.Title, &popularItinerary.LikesCount)
+	err = c.DB.QueryRowContext(ctx, popularItineraryQuery, req.UserId, req.ViewerId).Scan(&popularItinerary.Id, &popularItinerary.Title, &popularItinerary.LikesCount)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			popularItinerary.Id = ""
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"content/pkg/markdown"
+	"context"
+	"fmt"
+)
+
+const (
+	ContentFormatPlain    = "plain"
+	ContentFormatMarkdown = "markdown"
+)
+
+// MaxContentLength is the longest story content, in bytes, that is accepted
+// and rendered.
+const MaxContentLength = 100_000
+
+func validateContentFormat(format string) error {
+	if format != ContentFormatPlain && format != ContentFormatMarkdown {
+		return fmt.Errorf("unknown content format: %q", format)
+	}
+	return nil
+}
+
+func validateContentLength(content string) error {
+	if len(content) > MaxContentLength {
+		return fmt.Errorf("content of %d bytes is longer than %d bytes", len(content), MaxContentLength)
+	}
+	return nil
+}
+
+func renderContent(format, content string) string {
+	if format == ContentFormatMarkdown {
+		return markdown.Render(content)
+	}
+	return markdown.RenderPlain(content)
+}
+
+// refreshContentHTML renders the story again when its cached HTML is missing
+// or was produced by an older renderer, and stores the result. A failed store
+// only costs another render on the next read.
+func (c *StoryRepo) refreshContentHTML(ctx context.Context, story *pb.GetStoryRes, version int) {
+	if version == markdown.Version {
+		return
+	}
+
+	story.ContentHtml = renderContent(story.ContentFormat, story.Content)
+	c.DB.ExecContext(ctx, `
+        UPDATE stories SET content_html = $2, content_html_version = $3
+        WHERE id = $1 AND content = $4 AND content_format = $5
+    `, story.Id, story.ContentHtml, markdown.Version, story.Content, story.ContentFormat)
+}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	StoryStatusDraft     = "draft"
+	StoryStatusScheduled = "scheduled"
+	StoryStatusPublished = "published"
+	StoryStatusArchived  = "archived"
+)
+
+type PublishedStory struct {
+	Id          string
+	AuthorId    string
+	Title       string
+	PublishedAt string
+}
+
+func validateStoryStatus(status, publishAt string) error {
+	switch status {
+	case StoryStatusDraft, StoryStatusPublished, StoryStatusArchived:
+		return nil
+	case StoryStatusScheduled:
+		if publishAt == "" {
+			return fmt.Errorf("publish_at is required for scheduled stories")
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown story status: %q", status)
+}
+
+// ChangeStoryStatus moves a story between draft, scheduled, published and
+// archived on behalf of its author. The returned PublishedStory is non-nil
+// only when this call published the story.
+func (c *StoryRepo) ChangeStoryStatus(ctx context.Context, req *pb.ChangeStoryStatusReq) (*pb.StoryStatusRes, *PublishedStory, error) {
+	if err := validateStoryStatus(req.Status, req.PublishAt); err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	var previous string
+	err = tx.QueryRowContext(ctx, `
+        SELECT status FROM stories
+        WHERE id = $1 AND author_id = $2 AND deleted_at = 0
+        FOR UPDATE
+    `, req.Id, req.UserId).Scan(&previous)
+	if err == sql.ErrNoRows {
+		return nil, nil, fmt.Errorf("story %s not found or not written by user %s", req.Id, req.UserId)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query := `
+        UPDATE stories
+        SET status = $2,
+            publish_at = CASE WHEN $2 = 'scheduled' THEN NULLIF($3, '')::timestamptz ELSE publish_at END,
+            published_at = CASE WHEN $2 = 'published' AND NOT is_hidden THEN COALESCE(published_at, CURRENT_TIMESTAMP) ELSE published_at END,
+            updated_at = CURRENT_TIMESTAMP
+        WHERE id = $1
+        RETURNING id, status, COALESCE(publish_at::text, ''), published_at, title, is_hidden
+    `
+	var res pb.StoryStatusRes
+	var publishedAt sql.NullTime
+	var title string
+	var hidden bool
+	err = tx.QueryRowContext(ctx, query, req.Id, req.Status, req.PublishAt).Scan(
+		&res.Id, &res.Status, &res.PublishAt, &publishedAt, &title, &hidden)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Formatted like the timestamps scanned straight into strings elsewhere,
+	// which fanOut parses.
+	if publishedAt.Valid {
+		res.PublishedAt = publishedAt.Time.Format(time.RFC3339Nano)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	// Stories held for review are announced once a moderator lets them
+	// through.
+	if previous == StoryStatusPublished || res.Status != StoryStatusPublished || hidden {
+		return &res, nil, nil
+	}
+
+	return &res, &PublishedStory{Id: res.Id, AuthorId: req.UserId, Title: title, PublishedAt: res.PublishedAt}, nil
+}
+
+// ListDrafts lists the unpublished stories the caller co-authors. An empty
+// status lists drafts and scheduled stories together.
+func (c *StoryRepo) ListDrafts(ctx context.Context, req *pb.ListDraftsReq) (*pb.GetAllStoriesRes, error) {
+	statuses := []string{StoryStatusDraft, StoryStatusScheduled}
+	switch req.Status {
+	case "":
+	case StoryStatusDraft, StoryStatusScheduled:
+		statuses = []string{req.Status}
+	default:
+		return nil, fmt.Errorf("invalid draft status %q", req.Status)
+	}
+
+	query := `
+        SELECT s.id, s.title, COALESCE(s.location, ''), s.likes_count, s.comments_count,
+               s.status, COALESCE(s.publish_at::text, ''),
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, '')
+        FROM stories s
+        LEFT JOIN users u ON s.author_id = u.id
+        WHERE ` + storyAuthoredBy("s", "$1") + ` AND s.deleted_at = 0 AND s.status = ANY($2)
+        ORDER BY s.updated_at DESC
+        LIMIT $3 OFFSET $4
+    `
+	rows, err := c.DB.QueryContext(ctx, query, req.UserId, pq.Array(statuses), req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stories []*pb.Stories
+	for rows.Next() {
+		var story pb.Stories
+		var author pb.Author
+
+		err := rows.Scan(
+			&story.StoryId,
+			&story.Title,
+			&story.Location,
+			&story.LikesCount,
+			&story.CommentsCount,
+			&story.Status,
+			&story.PublishAt,
+			&author.UserId,
+			&author.Username,
+			&author.FullName,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		story.Author = &author
+		stories = append(stories, &story)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := c.attachCoAuthors(ctx, stories); err != nil {
+		return nil, err
+	}
+
+	countQuery := `
+        SELECT COUNT(*) FROM stories s
+        WHERE ` + storyAuthoredBy("s", "$1") + ` AND s.deleted_at = 0 AND s.status = ANY($2)
+    `
+	var total int64
+	if err := c.DB.QueryRowContext(ctx, countQuery, req.UserId, pq.Array(statuses)).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetAllStoriesRes{
+		Stories: stories,
+		Total:   total,
+		Offset:  req.Offset,
+		Limit:   req.Limit,
+	}, nil
+}
+
+// PublishDueStories publishes every scheduled story whose publish_at has
+// passed and returns the stories it published. Stories held for review wait
+// until a moderator lets them through.
+func (c *StoryRepo) PublishDueStories(ctx context.Context) ([]PublishedStory, error) {
+	query := `
+        UPDATE stories
+        SET status = 'published', published_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+        WHERE status = 'scheduled' AND publish_at <= CURRENT_TIMESTAMP AND deleted_at = 0 AND is_hidden = false
+        RETURNING id, COALESCE(author_id::text, ''), title, published_at
+    `
+	rows, err := c.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var published []PublishedStory
+	for rows.Next() {
+		var story PublishedStory
+		if err := rows.Scan(&story.Id, &story.AuthorId, &story.Title, &story.PublishedAt); err != nil {
+			return nil, err
+		}
+		published = append(published, story)
+	}
+
+	return published, rows.Err()
+}
@@ -0,0 +1,305 @@
+package postgres
+
+import (
+	pb "content/genproto/content"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+const (
+	ErasePolicyDelete    = "delete"
+	ErasePolicyAnonymize = "anonymize"
+)
+
+// EraseUserContent removes everything a deleted user authored. With the delete
+// policy stories, itineraries, comments and tips are removed; with the anonymize
+// policy they are kept but detached from the author. Likes and messages are
+// personal data and are removed under both policies. Counters of the stories
+// and itineraries the user interacted with are recalculated afterwards.
+func (c *ContentRepo) EraseUserContent(ctx context.Context, req *pb.EraseUserContentReq) (*pb.EraseUserContentRes, error) {
+	if req.Policy != ErasePolicyDelete && req.Policy != ErasePolicyAnonymize {
+		return nil, fmt.Errorf("unknown erasure policy: %q", req.Policy)
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var deletedAt int64
+	err = tx.QueryRowContext(ctx, `SELECT deleted_at FROM users WHERE id = $1`, req.UserId).Scan(&deletedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %v", err)
+	}
+	if deletedAt == 0 {
+		return nil, fmt.Errorf("user %s is not deleted", req.UserId)
+	}
+
+	res := &pb.EraseUserContentRes{
+		UserId: req.UserId,
+		Policy: req.Policy,
+	}
+
+	affectedStories, err := queryIDs(ctx, tx, `
+        SELECT story_id FROM likes WHERE user_id = $1
+        UNION
+        SELECT story_id FROM comments WHERE author_id = $1
+    `, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	affectedItineraries, err := queryIDs(ctx, tx, `
+        SELECT DISTINCT itinerary_id FROM comment WHERE author_id = $1
+    `, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Likes, err = execCount(ctx, tx, `DELETE FROM likes WHERE user_id = $1`, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if res.Bookmarks, err = execCount(ctx, tx, `DELETE FROM bookmarks WHERE user_id = $1`, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mentions WHERE mentioned_user_id = $1`, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM story_collaborators WHERE user_id = $1`, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if res.Messages, err = execCount(ctx, tx, `
+        DELETE FROM messages WHERE sender_id = $1 OR recipient_id = $1
+    `, req.UserId); err != nil {
+		return nil, err
+	}
+
+	editorQueries := []string{
+		`UPDATE story_revisions SET editor_id = NULL WHERE editor_id = $1`,
+		`UPDATE stories SET updated_by = NULL WHERE updated_by = $1`,
+		`UPDATE story_collaborators SET invited_by = NULL WHERE invited_by = $1`,
+	}
+	for _, query := range editorQueries {
+		if _, err := tx.ExecContext(ctx, query, req.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Reports and moderation decisions outlive the users involved so the
+	// moderation history stays intact.
+	moderationQueries := []string{
+		`UPDATE reports SET reporter_id = NULL WHERE reporter_id = $1`,
+		`UPDATE reports SET resolved_by = NULL WHERE resolved_by = $1`,
+		`UPDATE moderation_actions SET moderator_id = NULL WHERE moderator_id = $1`,
+		`UPDATE moderation_actions SET target_user_id = NULL WHERE target_user_id = $1`,
+	}
+	for _, query := range moderationQueries {
+		if _, err := tx.ExecContext(ctx, query, req.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Policy == ErasePolicyAnonymize {
+		err = anonymizeUserContent(ctx, tx, req.UserId, res)
+	} else {
+		err = deleteUserContent(ctx, tx, req.UserId, res)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if res.RecalculatedStories, err = execCount(ctx, tx, `
+        UPDATE stories s
+        SET likes_count = (SELECT COUNT(*) FROM likes l WHERE l.story_id = s.id),
+            comments_count = (SELECT COUNT(*) FROM comments c WHERE c.story_id = s.id AND c.deleted_at = 0)
+        WHERE s.id = ANY($1)
+    `, pq.Array(affectedStories)); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+        UPDATE comments p
+        SET replies_count = (SELECT COUNT(*) FROM comments c WHERE c.parent_comment_id = p.id AND c.deleted_at = 0)
+        WHERE p.story_id = ANY($1)
+    `, pq.Array(affectedStories)); err != nil {
+		return nil, err
+	}
+
+	if res.RecalculatedItineraries, err = execCount(ctx, tx, `
+        UPDATE itineraries i
+        SET comments_count = (SELECT COUNT(*) FROM comment c WHERE c.itinerary_id = i.id AND c.deleted_at = 0)
+        WHERE i.id = ANY($1)
+    `, pq.Array(affectedItineraries)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func anonymizeUserContent(ctx context.Context, tx *sql.Tx, userID string, res *pb.EraseUserContentRes) error {
+	var err error
+
+	if res.StoryComments, err = execCount(ctx, tx, `UPDATE comments SET author_id = NULL WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+	if res.ItineraryComments, err = execCount(ctx, tx, `UPDATE comment SET author_id = NULL WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+	if res.Stories, err = execCount(ctx, tx, `UPDATE stories SET author_id = NULL WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+	if res.Itineraries, err = execCount(ctx, tx, `UPDATE itineraries SET author_id = NULL WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+	if res.Tips, err = execCount(ctx, tx, `UPDATE travel_tips SET author_id = NULL WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE collections SET owner_id = NULL WHERE owner_id = $1`, userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE mentions SET author_id = NULL WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE media SET owner_id = NULL WHERE owner_id = $1`, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func deleteUserContent(ctx context.Context, tx *sql.Tx, userID string, res *pb.EraseUserContentRes) error {
+	var err error
+
+	repliesQuery := `
+        UPDATE comments SET parent_comment_id = NULL
+        WHERE parent_comment_id IN (SELECT id FROM comments WHERE author_id = $1)
+    `
+	if _, err := tx.ExecContext(ctx, repliesQuery, userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mentions WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+	if res.StoryComments, err = execCount(ctx, tx, `DELETE FROM comments WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+	if res.ItineraryComments, err = execCount(ctx, tx, `DELETE FROM comment WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+
+	storyQueries := []string{
+		`DELETE FROM likes WHERE story_id IN (SELECT id FROM stories WHERE author_id = $1)`,
+		`DELETE FROM comments WHERE story_id IN (SELECT id FROM stories WHERE author_id = $1)`,
+		`DELETE FROM story_tags WHERE story_id IN (SELECT id FROM stories WHERE author_id = $1)`,
+		`DELETE FROM story_revisions WHERE story_id IN (SELECT id FROM stories WHERE author_id = $1)`,
+		`DELETE FROM story_media WHERE story_id IN (SELECT id FROM stories WHERE author_id = $1)`,
+		`DELETE FROM bookmarks WHERE entity_type = 'story' AND entity_id IN (SELECT id FROM stories WHERE author_id = $1)`,
+		`DELETE FROM collection_stories WHERE story_id IN (SELECT id FROM stories WHERE author_id = $1)`,
+		`DELETE FROM mentions WHERE target_type = 'story' AND target_id IN (SELECT id FROM stories WHERE author_id = $1)`,
+		`DELETE FROM story_collaborators WHERE story_id IN (SELECT id FROM stories WHERE author_id = $1)`,
+	}
+	for _, query := range storyQueries {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return err
+		}
+	}
+	if res.Stories, err = execCount(ctx, tx, `DELETE FROM stories WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+
+	itineraryQueries := []string{
+		`DELETE FROM comment WHERE itinerary_id IN (SELECT id FROM itineraries WHERE author_id = $1)`,
+		`DELETE FROM itinerary_media WHERE itinerary_id IN (SELECT id FROM itineraries WHERE author_id = $1)`,
+		`DELETE FROM bookmarks WHERE entity_type = 'itinerary' AND entity_id IN (SELECT id FROM itineraries WHERE author_id = $1)`,
+		`DELETE FROM mentions WHERE target_type = 'itinerary' AND target_id IN (SELECT id FROM itineraries WHERE author_id = $1)`,
+		`DELETE FROM itinerary_activities WHERE destination_id IN (
+            SELECT d.id FROM itinerary_destinations d
+            JOIN itineraries i ON d.itinerary_id = i.id
+            WHERE i.author_id = $1
+        )`,
+		`DELETE FROM itinerary_destinations WHERE itinerary_id IN (SELECT id FROM itineraries WHERE author_id = $1)`,
+	}
+	for _, query := range itineraryQueries {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return err
+		}
+	}
+	if res.Itineraries, err = execCount(ctx, tx, `DELETE FROM itineraries WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+        DELETE FROM bookmarks WHERE entity_type = 'tip' AND entity_id IN (SELECT id FROM travel_tips WHERE author_id = $1)
+    `, userID); err != nil {
+		return err
+	}
+	if res.Tips, err = execCount(ctx, tx, `DELETE FROM travel_tips WHERE author_id = $1`, userID); err != nil {
+		return err
+	}
+
+	collectionQueries := []string{
+		`DELETE FROM collection_stories WHERE collection_id IN (SELECT id FROM collections WHERE owner_id = $1)`,
+		`DELETE FROM collections WHERE owner_id = $1`,
+	}
+	for _, query := range collectionQueries {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return err
+		}
+	}
+
+	// Stored files stay in the blob store; without a media row they are no
+	// longer reachable through the API.
+	mediaQueries := []string{
+		`DELETE FROM story_media WHERE media_id IN (SELECT id FROM media WHERE owner_id = $1)`,
+		`DELETE FROM itinerary_media WHERE media_id IN (SELECT id FROM media WHERE owner_id = $1)`,
+		`DELETE FROM media_renditions WHERE media_id IN (SELECT id FROM media WHERE owner_id = $1)`,
+		`DELETE FROM media WHERE owner_id = $1`,
+	}
+	for _, query := range mediaQueries {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func execCount(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (int64, error) {
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func queryIDs(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	pb "content/genproto/content"
+	"context"
+	"database/sql"
+	"testing"
+)
+
+const (
+	erasedUserID       = "7c1f3a52-9e4b-4d1a-8f0e-2b6d5c3a9e01"
+	remainingUserID    = "7c1f3a52-9e4b-4d1a-8f0e-2b6d5c3a9e02"
+	erasedStoryID      = "7c1f3a52-9e4b-4d1a-8f0e-2b6d5c3a9e11"
+	remainingStoryID   = "7c1f3a52-9e4b-4d1a-8f0e-2b6d5c3a9e12"
+	erasedCommentID    = "7c1f3a52-9e4b-4d1a-8f0e-2b6d5c3a9e21"
+	remainingCommentID = "7c1f3a52-9e4b-4d1a-8f0e-2b6d5c3a9e22"
+)
+
+// seedErasure creates a deleted user who wrote a story, liked and commented
+// on another user's story, and got a comment on their own story. The
+// counters of the other user's story are deliberately stale.
+func seedErasure(t *testing.T, db *sql.DB) {
+	cleanErasure(t, db)
+
+	queries := []string{
+		`INSERT INTO users (id, username, email, password, full_name, deleted_at)
+         VALUES ('` + erasedUserID + `', 'erased', 'erased@example.com', 'secret', 'Erased User', 1)`,
+		`INSERT INTO users (id, username, email, password, full_name)
+         VALUES ('` + remainingUserID + `', 'remaining', 'remaining@example.com', 'secret', 'Remaining User')`,
+		`INSERT INTO stories (id, title, content, author_id)
+         VALUES ('` + erasedStoryID + `', 'erased', 'erased', '` + erasedUserID + `')`,
+		`INSERT INTO stories (id, title, content, author_id, likes_count, comments_count)
+         VALUES ('` + remainingStoryID + `', 'remaining', 'remaining', '` + remainingUserID + `', 7, 7)`,
+		`INSERT INTO likes (user_id, story_id) VALUES ('` + erasedUserID + `', '` + remainingStoryID + `')`,
+		`INSERT INTO likes (user_id, story_id) VALUES ('` + remainingUserID + `', '` + remainingStoryID + `')`,
+		`INSERT INTO comments (id, content, author_id, story_id)
+         VALUES ('` + erasedCommentID + `', 'erased', '` + erasedUserID + `', '` + remainingStoryID + `')`,
+		`INSERT INTO comments (id, content, author_id, story_id)
+         VALUES ('` + remainingCommentID + `', 'remaining', '` + remainingUserID + `', '` + erasedStoryID + `')`,
+	}
+	for _, query := range queries {
+		if _, err := db.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func cleanErasure(t *testing.T, db *sql.DB) {
+	queries := []string{
+		`DELETE FROM likes WHERE story_id IN ($1, $2)`,
+		`DELETE FROM comments WHERE story_id IN ($1, $2)`,
+		`DELETE FROM stories WHERE id IN ($1, $2)`,
+	}
+	for _, query := range queries {
+		if _, err := db.Exec(query, erasedStoryID, remainingStoryID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.Exec(`DELETE FROM users WHERE id IN ($1, $2)`, erasedUserID, remainingUserID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func storyCounters(t *testing.T, db *sql.DB, storyID string) (likes, comments int64) {
+	err := db.QueryRow(`SELECT likes_count, comments_count FROM stories WHERE id = $1`, storyID).Scan(&likes, &comments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return likes, comments
+}
+
+func TestEraseUserContentDelete(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	seedErasure(t, db)
+	defer cleanErasure(t, db)
+
+	con := NewContentRepository(db)
+	res, err := con.EraseUserContent(context.Background(), &pb.EraseUserContentReq{
+		UserId: erasedUserID,
+		Policy: ErasePolicyDelete,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Stories != 1 || res.Likes != 1 || res.StoryComments != 1 || res.RecalculatedStories != 1 {
+		t.Errorf("EraseUserContent returned %+v, want 1 story, like, comment and recalculated story", res)
+	}
+
+	var stories int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM stories WHERE id = $1`, erasedStoryID).Scan(&stories); err != nil {
+		t.Fatal(err)
+	}
+	if stories != 0 {
+		t.Errorf("story %s was not deleted", erasedStoryID)
+	}
+
+	var comments int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM comments WHERE id IN ($1, $2)`, erasedCommentID, remainingCommentID).Scan(&comments); err != nil {
+		t.Fatal(err)
+	}
+	if comments != 0 {
+		t.Errorf("%d comments left, want the user's comments and the comments on their story deleted", comments)
+	}
+
+	likes, commentsCount := storyCounters(t, db, remainingStoryID)
+	if likes != 1 || commentsCount != 0 {
+		t.Errorf("story counters are %d likes, %d comments, want 1 like, 0 comments", likes, commentsCount)
+	}
+}
+
+func TestEraseUserContentAnonymize(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	seedErasure(t, db)
+	defer cleanErasure(t, db)
+
+	con := NewContentRepository(db)
+	res, err := con.EraseUserContent(context.Background(), &pb.EraseUserContentReq{
+		UserId: erasedUserID,
+		Policy: ErasePolicyAnonymize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Stories != 1 || res.Likes != 1 || res.StoryComments != 1 || res.RecalculatedStories != 1 {
+		t.Errorf("EraseUserContent returned %+v, want 1 story, like, comment and recalculated story", res)
+	}
+
+	var storyAuthor sql.NullString
+	if err := db.QueryRow(`SELECT author_id FROM stories WHERE id = $1`, erasedStoryID).Scan(&storyAuthor); err != nil {
+		t.Fatal(err)
+	}
+	if storyAuthor.Valid {
+		t.Errorf("story %s still has author %s", erasedStoryID, storyAuthor.String)
+	}
+
+	var commentAuthor sql.NullString
+	if err := db.QueryRow(`SELECT author_id FROM comments WHERE id = $1`, erasedCommentID).Scan(&commentAuthor); err != nil {
+		t.Fatal(err)
+	}
+	if commentAuthor.Valid {
+		t.Errorf("comment %s still has author %s", erasedCommentID, commentAuthor.String)
+	}
+
+	var remainingComments int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM comments WHERE id = $1`, remainingCommentID).Scan(&remainingComments); err != nil {
+		t.Fatal(err)
+	}
+	if remainingComments != 1 {
+		t.Errorf("comment %s on the anonymized story was removed", remainingCommentID)
+	}
+
+	likes, comments := storyCounters(t, db, remainingStoryID)
+	if likes != 1 || comments != 1 {
+		t.Errorf("story counters are %d likes, %d comments, want 1 like, 1 comment", likes, comments)
+	}
+}
+
+func TestEraseUserContentUnknownPolicy(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	con := NewContentRepository(db)
+	_, err = con.EraseUserContent(context.Background(), &pb.EraseUserContentReq{
+		UserId: erasedUserID,
+		Policy: "forget",
+	})
+	if err == nil {
+		t.Errorf("EraseUserContent accepted an unknown policy")
+	}
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	pb "content/genproto/content"
+	"content/pkg/cursor"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// followedContent lists the published public and followers-only stories and
+// itineraries written by the authors $1 follows. Every row gets a feed key ("story:<id>") and a score,
+// its publication time in milliseconds; the feed is ordered by both.
+const followedContent = `
+        SELECT feed.*, floor(extract(epoch FROM feed.ts) * 1000)::bigint AS score,
+               (feed.type || ':' || feed.id) COLLATE "C" AS key
+        FROM (
+            SELECT 'story' AS type, s.id::text AS id, s.title, COALESCE(s.location, '') AS summary,
+                   s.author_id, s.published_at AS ts
+            FROM stories s
+            JOIN followers f ON f.following_id = s.author_id
+            WHERE f.follower_id = $1 AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+              AND s.visibility IN ('public', 'followers')
+            UNION ALL
+            SELECT 'itinerary', i.id::text, i.title, COALESCE(i.description, ''), i.author_id, i.created_at
+            FROM itineraries i
+            JOIN followers f ON f.following_id = i.author_id
+            WHERE f.follower_id = $1 AND i.deleted_at = 0 AND i.visibility IN ('public', 'followers')
+        ) feed
+`
+
+const feedColumns = `
+        f.type, f.id, f.title, f.summary, f.ts, f.score, f.key,
+        COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, '')
+`
+
+// FeedEntry is a feed item together with its position in the feed.
+type FeedEntry struct {
+	Item     *pb.FeedItem
+	Position cursor.Cursor
+}
+
+// GetFeed reads the caller's feed straight from the database, newest first,
+// starting after the given position.
+func (c *ContentRepo) GetFeed(ctx context.Context, userID string, after *cursor.Cursor, limit int64) ([]FeedEntry, error) {
+	var score int64
+	var key string
+	if after != nil {
+		score, key = after.Score, after.Key
+	}
+
+	query := `
+        SELECT ` + feedColumns + `
+        FROM (` + followedContent + `) f
+        LEFT JOIN users u ON f.author_id = u.id
+        WHERE $2 = 0 OR (f.score, f.key) < ($2, $3 COLLATE "C")
+        ORDER BY f.score DESC, f.key DESC
+        LIMIT $4
+    `
+	rows, err := c.DB.QueryContext(ctx, query, userID, score, key, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch feed: %v", err)
+	}
+
+	return scanFeed(rows)
+}
+
+// FeedEntries looks up feed items by key. Keys of deleted items, or of items
+// whose author the user no longer follows, are left out.
+func (c *ContentRepo) FeedEntries(ctx context.Context, userID string, keys []string) (map[string]FeedEntry, error) {
+	query := `
+        SELECT ` + feedColumns + `
+        FROM (` + followedContent + `) f
+        LEFT JOIN users u ON f.author_id = u.id
+        WHERE f.key = ANY($2)
+    `
+	rows, err := c.DB.QueryContext(ctx, query, userID, pq.Array(keys))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch feed items: %v", err)
+	}
+
+	entries, err := scanFeed(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]FeedEntry, len(entries))
+	for _, entry := range entries {
+		byKey[entry.Position.Key] = entry
+	}
+	return byKey, nil
+}
+
+func scanFeed(rows *sql.Rows) ([]FeedEntry, error) {
+	defer rows.Close()
+
+	var entries []FeedEntry
+	for rows.Next() {
+		var item pb.FeedItem
+		var author pb.Author
+		var position cursor.Cursor
+
+		err := rows.Scan(
+			&item.Type,
+			&item.Id,
+			&item.Title,
+			&item.Summary,
+			&item.CreatedAt,
+			&position.Score,
+			&position.Key,
+			&author.UserId,
+			&author.Username,
+			&author.FullName,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feed item: %v", err)
+		}
+
+		item.Author = &author
+		entries = append(entries, FeedEntry{Item: &item, Position: position})
+	}
+
+	return entries, rows.Err()
+}
+
+func followerIDs(ctx context.Context, db *sql.DB, userID string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `SELECT follower_id FROM followers WHERE following_id = $1`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func (c *StoryRepo) FollowerIDs(ctx context.Context, userID string) ([]string, error) {
+	return followerIDs(ctx, c.DB, userID)
+}
+
+func (c *ItinerariesRepo) FollowerIDs(ctx context.Context, userID string) ([]string, error) {
+	return followerIDs(ctx, c.DB, userID)
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"content/pkg/geo"
+	"database/sql"
+)
+
+// geoColumns scans the nullable latitude, longitude and place_name columns
+// shared by stories, itinerary destinations and destinations.
+type geoColumns struct {
+	Latitude  sql.NullFloat64
+	Longitude sql.NullFloat64
+	PlaceName sql.NullString
+}
+
+func (g *geoColumns) dest() []interface{} {
+	return []interface{}{&g.Latitude, &g.Longitude, &g.PlaceName}
+}
+
+// geoParams validates an optional point and returns the values to store in
+// the latitude, longitude and place_name columns; nil stands for NULL.
+func geoParams(set bool, lat, lng float64, placeName string) ([]interface{}, error) {
+	if !set {
+		return []interface{}{nil, nil, nil}, nil
+	}
+	if err := geo.Validate(lat, lng); err != nil {
+		return nil, err
+	}
+	var place interface{}
+	if placeName != "" {
+		place = placeName
+	}
+	return []interface{}{lat, lng, place}, nil
+}
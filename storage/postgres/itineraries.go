@@ -16,6 +16,13 @@ func NewItinerariesRepository(db *sql.DB) *ItinerariesRepo {
 }
 
 func (c *ItinerariesRepo) Itineraries(ctx context.Context, req *pb.ItinerariesReq) (*pb.ItinerariesRes, error) {
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = VisibilityPublic
+	}
+	if err := validateVisibility(visibility); err != nil {
+		return nil, err
+	}
 
 	tx, err := c.DB.BeginTx(ctx, nil)
 	if err != nil {
@@ -23,26 +30,34 @@ func (c *ItinerariesRepo) Itineraries(ctx context.Context, req *pb.ItinerariesRe
 	}
 
 	itineraryQuery := `
-        INSERT INTO itineraries (title, description, start_date, end_date, author_id, created_at)
-        VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
-        RETURNING id, title, description, start_date, end_date, author_id, created_at
+        INSERT INTO itineraries (title, description, start_date, end_date, author_id, visibility, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)
+        RETURNING id, title, description, start_date, end_date, author_id, created_at, visibility
     `
 	var itinerary pb.ItinerariesRes
-	err = tx.QueryRowContext(ctx, itineraryQuery, req.Title, req.Description, req.StartDate, req.EndDate, req.UserId).Scan(
-		&itinerary.Id, &itinerary.Title, &itinerary.Description, &itinerary.StartDate, &itinerary.EndDate, &itinerary.UserId, &itinerary.CreatedAt)
+	err = tx.QueryRowContext(ctx, itineraryQuery, req.Title, req.Description, req.StartDate, req.EndDate, req.UserId, visibility).Scan(
+		&itinerary.Id, &itinerary.Title, &itinerary.Description, &itinerary.StartDate, &itinerary.EndDate, &itinerary.UserId, &itinerary.CreatedAt,
+		&itinerary.Visibility)
 	if err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
 	destinationQuery := `
-        INSERT INTO itinerary_destinations (itinerary_id, name, start_date, end_date)
-        VALUES ($1, $2, $3, $4)
+        INSERT INTO itinerary_destinations (itinerary_id, name, start_date, end_date, latitude, longitude, place_name)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
         RETURNING id
     `
 	for _, dest := range req.Destinations {
+		point, err := geoParams(dest.Geo != nil, dest.Geo.GetLatitude(), dest.Geo.GetLongitude(), dest.Geo.GetPlaceName())
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
 		var destinationID string
-		err = tx.QueryRowContext(ctx, destinationQuery, itinerary.Id, dest.Name, dest.StartDate, dest.EndDate).Scan(&destinationID)
+		err = tx.QueryRowContext(ctx, destinationQuery, itinerary.Id, dest.Name, dest.StartDate, dest.EndDate,
+			point[0], point[1], point[2]).Scan(&destinationID)
 		if err != nil {
 			tx.Rollback()
 			return nil, err
@@ -69,23 +84,46 @@ func (c *ItinerariesRepo) Itineraries(ctx context.Context, req *pb.ItinerariesRe
 }
 
 func (c *ItinerariesRepo) UpdateItineraries(ctx context.Context, req *pb.UpdateItinerariesReq) (*pb.ItinerariesRes, error) {
+	if err := validateVisibility(req.Visibility); err != nil {
+		return nil, err
+	}
 
 	tx, err := c.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.Visibility != "" {
+		var visibility string
+		var isAuthor bool
+		err = tx.QueryRowContext(ctx, `
+            SELECT visibility, COALESCE(author_id = NULLIF($2, '')::uuid, false)
+            FROM itineraries WHERE id = $1 AND deleted_at = 0 FOR UPDATE
+        `, req.Id, req.UserId).Scan(&visibility, &isAuthor)
+		if err == sql.ErrNoRows {
+			err = fmt.Errorf("itinerary %s not found", req.Id)
+		}
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if visibility != req.Visibility && !isAuthor {
+			tx.Rollback()
+			return nil, fmt.Errorf("only the author may change the visibility of itinerary %s", req.Id)
+		}
+	}
+
 	query := `
         UPDATE itineraries
-        SET title = $1, description = $2, updated_at = CURRENT_TIMESTAMP
+        SET title = $1, description = $2, visibility = COALESCE(NULLIF($4, ''), visibility), updated_at = CURRENT_TIMESTAMP
         WHERE id = $3 AND deleted_at = 0
-        RETURNING id, title, description, start_date, end_date, author_id, created_at
+        RETURNING id, title, description, start_date, end_date, author_id, created_at, visibility
     `
 	var updatedItinerary pb.ItinerariesRes
-	err = tx.QueryRowContext(ctx, query, req.Title, req.Description, req.Id).Scan(
+	err = tx.QueryRowContext(ctx, query, req.Title, req.Description, req.Id, req.Visibility).Scan(
 		&updatedItinerary.Id, &updatedItinerary.Title, &updatedItinerary.Description,
 		&updatedItinerary.StartDate, &updatedItinerary.EndDate, &updatedItinerary.UserId,
-		&updatedItinerary.CreatedAt)
+		&updatedItinerary.CreatedAt, &updatedItinerary.Visibility)
 	if err != nil {
 		tx.Rollback()
 		return nil, err
@@ -115,6 +153,11 @@ func (c *ItinerariesRepo) DeleteItineraries(ctx context.Context, req *pb.StoryId
 		return err
 	}
 
+	if err := deleteBookmarks(ctx, tx, BookmarkTypeItinerary, req.Id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return err
 	}
@@ -125,20 +168,20 @@ func (c *ItinerariesRepo) DeleteItineraries(ctx context.Context, req *pb.StoryId
 func (c *ItinerariesRepo) GetItineraries(ctx context.Context, req *pb.GetItinerariesReq) (*pb.GetItinerariesRes, error) {
 
 	var total int64
-	totalQuery := `SELECT COUNT(*) FROM itineraries WHERE deleted_at = 0`
-	err := c.DB.QueryRowContext(ctx, totalQuery).Scan(&total)
+	totalQuery := `SELECT COUNT(*) FROM itineraries i WHERE i.deleted_at = 0 AND ` + listedTo("i", "$1")
+	err := c.DB.QueryRowContext(ctx, totalQuery, req.UserId).Scan(&total)
 	if err != nil {
 		return nil, err
 	}
 
 	itinerariesQuery := `
-        SELECT id, title, description, start_date, end_date, author_id, created_at
-        FROM itineraries
-        WHERE deleted_at = 0
-        ORDER BY created_at DESC
+        SELECT i.id, i.title, i.description, i.start_date, i.end_date, COALESCE(i.author_id::text, ''), i.created_at, i.visibility
+        FROM itineraries i
+        WHERE i.deleted_at = 0 AND ` + listedTo("i", "$3") + `
+        ORDER BY i.created_at DESC
         LIMIT $1 OFFSET $2
     `
-	rows, err := c.DB.QueryContext(ctx, itinerariesQuery, req.Limit, req.Offset)
+	rows, err := c.DB.QueryContext(ctx, itinerariesQuery, req.Limit, req.Offset, req.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +198,7 @@ func (c *ItinerariesRepo) GetItineraries(ctx context.Context, req *pb.GetItinera
 			&itinerary.EndDate,
 			&itinerary.UserId,
 			&itinerary.CreatedAt,
+			&itinerary.Visibility,
 		)
 		if err != nil {
 			return nil, err
@@ -166,6 +210,18 @@ func (c *ItinerariesRepo) GetItineraries(ctx context.Context, req *pb.GetItinera
 		return nil, err
 	}
 
+	ids := make([]string, len(itineraries))
+	for i, itinerary := range itineraries {
+		ids[i] = itinerary.Id
+	}
+	bookmarked, err := bookmarkedIDs(ctx, c.DB, req.UserId, BookmarkTypeItinerary, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, itinerary := range itineraries {
+		itinerary.IsBookmarked = bookmarked[itinerary.Id]
+	}
+
 	response := &pb.GetItinerariesRes{
 		Itineraries: itineraries,
 		Total:       total,
@@ -183,12 +239,14 @@ func (c *ItinerariesRepo) GetItinerariesById(ctx context.Context, req *pb.StoryI
 	}
 
 	itineraryQuery := `
-        SELECT i.id, i.title, i.description, i.start_date, i.end_date, u.id, u.username, u.full_name
+        SELECT i.id, i.title, i.description, i.start_date, i.end_date, COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, ''),
+               EXISTS (SELECT 1 FROM bookmarks b WHERE b.entity_type = 'itinerary' AND b.entity_id = i.id AND b.user_id = NULLIF($2, '')::uuid),
+               i.visibility
         FROM itineraries i
-        JOIN users u ON i.author_id = u.id
-        WHERE i.id = $1 AND i.deleted_at = 0
+        LEFT JOIN users u ON i.author_id = u.id
+        WHERE i.id = $1 AND i.deleted_at = 0 AND ` + visibleTo("i", "$2") + `
     `
-	err := c.DB.QueryRowContext(ctx, itineraryQuery, req.Id).Scan(
+	err := c.DB.QueryRowContext(ctx, itineraryQuery, req.Id, req.UserId).Scan(
 		&itinerary.Id,
 		&itinerary.Title,
 		&itinerary.Description,
@@ -197,13 +255,18 @@ func (c *ItinerariesRepo) GetItinerariesById(ctx context.Context, req *pb.StoryI
 		&itinerary.Author.UserId,
 		&itinerary.Author.Username,
 		&itinerary.Author.FullName,
+		&itinerary.IsBookmarked,
+		&itinerary.Visibility,
 	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("itinerary %s not found", req.Id)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	destinationsQuery := `
-        SELECT name, start_date, end_date
+        SELECT name, start_date, end_date, latitude, longitude, place_name
         FROM itinerary_destinations
         WHERE itinerary_id = $1
     `
@@ -216,14 +279,25 @@ func (c *ItinerariesRepo) GetItinerariesById(ctx context.Context, req *pb.StoryI
 	var destinations []*pb.Destination
 	for rows.Next() {
 		var destination pb.Destination
+		var point geoColumns
 		err := rows.Scan(
 			&destination.Name,
 			&destination.StartDate,
 			&destination.EndDate,
+			&point.Latitude,
+			&point.Longitude,
+			&point.PlaceName,
 		)
 		if err != nil {
 			return nil, err
 		}
+		if point.Latitude.Valid {
+			destination.Geo = &pb.GeoPoint{
+				Latitude:  point.Latitude.Float64,
+				Longitude: point.Longitude.Float64,
+				PlaceName: point.PlaceName.String,
+			}
+		}
 
 		activitiesQuery := `
             SELECT activity
@@ -264,18 +338,42 @@ func (c *ItinerariesRepo) GetItinerariesById(ctx context.Context, req *pb.StoryI
 
 	itinerary.Destination = destinations
 
+	media, err := c.itineraryMedia(ctx, itinerary.Id)
+	if err != nil {
+		return nil, err
+	}
+	itinerary.Media = media
+
 	return &itinerary, nil
 }
 
-func (c *ItinerariesRepo) CommentItineraries(ctx context.Context, req *pb.CommentItinerariesReq) (*pb.CommentItinerariesRes, error) {
+func (c *ItinerariesRepo) CommentItineraries(ctx context.Context, req *pb.CommentItinerariesReq, hold *Hold) (*pb.CommentItinerariesRes, []Mention, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	var visible bool
+	err = tx.QueryRowContext(ctx, `
+        SELECT TRUE FROM itineraries i
+        WHERE i.id::text = $1 AND i.deleted_at = 0 AND `+visibleTo("i", "$2")+`
+    `, req.ItineraryId, req.AuthorId).Scan(&visible)
+	if err == sql.ErrNoRows {
+		return nil, nil, fmt.Errorf("itinerary %s not found", req.ItineraryId)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
 	query := `
-        INSERT INTO comment (id, content, author_id, itinerary_id, created_at)
-        VALUES (gen_random_uuid(), $1, $2, $3, CURRENT_TIMESTAMP)
+        INSERT INTO comment (id, content, author_id, itinerary_id, created_at, is_hidden)
+        VALUES (gen_random_uuid(), $1, $2, $3, CURRENT_TIMESTAMP, $4)
         RETURNING id, author_id, content, itinerary_id, created_at
     `
 
 	var comment pb.CommentItinerariesRes
-	err := c.DB.QueryRowContext(ctx, query, req.Content, req.AuthorId, req.ItineraryId).Scan(
+	err = tx.QueryRowContext(ctx, query, req.Content, req.AuthorId, req.ItineraryId, hold != nil).Scan(
 		&comment.Id,
 		&comment.AuthorId,
 		&comment.Content,
@@ -283,8 +381,114 @@ func (c *ItinerariesRepo) CommentItineraries(ctx context.Context, req *pb.Commen
 		&comment.CreatedAt,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to insert comment: %v", err)
+		return nil, nil, fmt.Errorf("failed to insert comment: %v", err)
+	}
+	if err := fileHold(ctx, tx, hold, ReportTypeItineraryComment, comment.Id, req.AuthorId); err != nil {
+		return nil, nil, err
+	}
+
+	updateQuery := `UPDATE itineraries SET comments_count = comments_count + 1 WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, updateQuery, req.ItineraryId); err != nil {
+		return nil, nil, fmt.Errorf("failed to update comments count: %v", err)
+	}
+
+	mentions, err := saveMentions(ctx, tx, Mention{
+		AuthorId:   req.AuthorId,
+		SourceType: MentionSourceItineraryComment,
+		SourceId:   comment.Id,
+		TargetType: MentionTargetItinerary,
+		TargetId:   req.ItineraryId,
+	}, req.Content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return &comment, mentions, nil
+}
+
+func (c *ItinerariesRepo) EditComment(ctx context.Context, req *pb.EditCommentReq) (*pb.CommentItinerariesRes, error) {
+	query := `
+        UPDATE comment
+        SET content = $1, edited_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND author_id = $3 AND deleted_at = 0
+        RETURNING id, author_id, content, itinerary_id, created_at, edited_at
+    `
+
+	var comment pb.CommentItinerariesRes
+	err := c.DB.QueryRowContext(ctx, query, req.Content, req.Id, req.UserId).Scan(
+		&comment.Id,
+		&comment.AuthorId,
+		&comment.Content,
+		&comment.ItineraryId,
+		&comment.CreatedAt,
+		&comment.EditedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("comment %s not found or not written by user %s", req.Id, req.UserId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit comment: %v", err)
 	}
 
 	return &comment, nil
 }
+
+// DeleteComment soft-deletes an itinerary comment on behalf of its author or
+// a moderator and takes it out of comments_count.
+func (c *ItinerariesRepo) DeleteComment(ctx context.Context, req *pb.DeleteCommentReq) error {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var itineraryID string
+	var isAuthor bool
+	var deletedAt int64
+	commentQuery := `
+        SELECT COALESCE(author_id = NULLIF($2, '')::uuid, false), itinerary_id, deleted_at
+        FROM comment
+        WHERE id = $1
+        FOR UPDATE
+    `
+	err = tx.QueryRowContext(ctx, commentQuery, req.Id, req.UserId).Scan(&isAuthor, &itineraryID, &deletedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("comment %s not found", req.Id)
+	}
+	if err != nil {
+		return err
+	}
+	if deletedAt != 0 {
+		return nil
+	}
+
+	if !isAuthor {
+		moderator, err := isModerator(ctx, tx, req.UserId)
+		if err != nil {
+			return err
+		}
+		if !moderator {
+			return fmt.Errorf("user %s may not delete comment %s", req.UserId, req.Id)
+		}
+	}
+
+	deleteQuery := `
+        UPDATE comment
+        SET deleted_at = date_part('epoch', current_timestamp)::INT
+        WHERE id = $1
+    `
+	if _, err := tx.ExecContext(ctx, deleteQuery, req.Id); err != nil {
+		return err
+	}
+
+	updateQuery := `UPDATE itineraries SET comments_count = GREATEST(comments_count - 1, 0) WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, updateQuery, itineraryID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	pb "content/genproto/itineraries"
+	"context"
+	"encoding/json"
+)
+
+// SetItineraryMedia replaces the ordered media list of an itinerary. Only the author
+// can change it, and only with media they uploaded themselves.
+func (c *ItinerariesRepo) SetItineraryMedia(ctx context.Context, req *pb.SetItineraryMediaReq) (*pb.ItineraryMediaRes, error) {
+	if err := setAttachedMedia(ctx, c.DB, itineraryMediaList, req.ItineraryId, req.UserId, req.MediaIds); err != nil {
+		return nil, err
+	}
+
+	media, err := c.itineraryMedia(ctx, req.ItineraryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ItineraryMediaRes{
+		ItineraryId: req.ItineraryId,
+		Media:       media,
+	}, nil
+}
+
+func (c *ItinerariesRepo) itineraryMedia(ctx context.Context, itineraryID string) ([]*pb.Media, error) {
+	return attachedMedia(ctx, c.DB, itineraryMediaList, itineraryID, scanItineraryMedia)
+}
+
+func scanItineraryMedia(row interface{ Scan(...interface{}) error }) (*pb.Media, error) {
+	var m pb.Media
+	var renditions []byte
+	err := row.Scan(&m.Id, &m.OwnerId, &m.Url, &m.ContentType, &m.Size, &m.Width, &m.Height,
+		&m.Checksum, &m.AltText, &m.Status, &m.CreatedAt, &m.DominantColor, &renditions)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(renditions, &m.Renditions); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
@@ -0,0 +1,273 @@
+package postgres
+
+import (
+	pb "content/genproto/content"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// MaxAttachedMedia caps how many media items one story or itinerary can hold.
+const MaxAttachedMedia = 30
+
+const mediaColumns = `
+        m.id, COALESCE(m.owner_id::text, ''), m.url, m.content_type, m.size_bytes, m.width, m.height,
+        m.checksum, m.alt_text, m.status, m.created_at, m.dominant_color,
+        COALESCE((
+            SELECT json_agg(json_build_object('name', r.name, 'url', r.url, 'width', r.width, 'height', r.height)
+                            ORDER BY r.width)
+            FROM media_renditions r
+            WHERE r.media_id = m.id
+        ), '[]')
+`
+
+// CreateMedia records an uploaded file. New media start out pending until the
+// processing pipeline has handled them.
+func (c *ContentRepo) CreateMedia(ctx context.Context, media *pb.Media, storageKey string, keepLocation bool) (*pb.Media, error) {
+	query := `
+        INSERT INTO media (owner_id, storage_key, url, content_type, size_bytes, width, height, checksum, alt_text, keep_location)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+        RETURNING id, status, created_at
+    `
+	err := c.DB.QueryRowContext(ctx, query, media.OwnerId, storageKey, media.Url, media.ContentType, media.Size,
+		media.Width, media.Height, media.Checksum, media.AltText, keepLocation).Scan(&media.Id, &media.Status, &media.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save media: %v", err)
+	}
+
+	return media, nil
+}
+
+// mediaList describes where the ordered media of a story or itinerary are
+// kept: table holds one row per attached media item, pointing at the id of
+// parent through column.
+type mediaList struct {
+	kind   string
+	parent string
+	table  string
+	column string
+}
+
+var (
+	storyMediaList     = mediaList{kind: "story", parent: "stories", table: "story_media", column: "story_id"}
+	itineraryMediaList = mediaList{kind: "itinerary", parent: "itineraries", table: "itinerary_media", column: "itinerary_id"}
+)
+
+// setAttachedMedia replaces the ordered media list of the story or itinerary
+// id on behalf of userID, who must be its author.
+func setAttachedMedia(ctx context.Context, db *sql.DB, list mediaList, id, userID string, mediaIDs []string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var isAuthor bool
+	err = tx.QueryRowContext(ctx, fmt.Sprintf(`
+        SELECT COALESCE(author_id = NULLIF($2, '')::uuid, false)
+        FROM %s WHERE id = $1 AND deleted_at = 0 FOR UPDATE
+    `, list.parent), id, userID).Scan(&isAuthor)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("%s %s not found", list.kind, id)
+	}
+	if err != nil {
+		return err
+	}
+	if !isAuthor {
+		return fmt.Errorf("user %s is not the author of %s %s", userID, list.kind, id)
+	}
+
+	if err := replaceMediaList(ctx, tx, list.table, list.column, id, userID, mediaIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// attachedMedia loads the live media attached to the story or itinerary id,
+// in order, and converts every row with scan.
+func attachedMedia[M any](ctx context.Context, db *sql.DB, list mediaList, id string, scan func(interface{ Scan(...interface{}) error }) (M, error)) ([]M, error) {
+	query := fmt.Sprintf(`
+        SELECT `+mediaColumns+`
+        FROM %s am
+        JOIN media m ON am.media_id = m.id
+        WHERE am.%s = $1 AND m.deleted_at = 0
+        ORDER BY am.position
+    `, list.table, list.column)
+	rows, err := db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var media []M
+	for rows.Next() {
+		m, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		media = append(media, m)
+	}
+
+	return media, rows.Err()
+}
+
+// replaceMediaList swaps the ordered media list kept in table for the row
+// identified by column = id. Only live media owned by ownerID can be attached.
+func replaceMediaList(ctx context.Context, tx *sql.Tx, table, column, id, ownerID string, mediaIDs []string) error {
+	if len(mediaIDs) > MaxAttachedMedia {
+		return fmt.Errorf("at most %d media items can be attached", MaxAttachedMedia)
+	}
+
+	seen := make(map[string]bool, len(mediaIDs))
+	for _, mediaID := range mediaIDs {
+		if seen[mediaID] {
+			return fmt.Errorf("media %s is listed twice", mediaID)
+		}
+		seen[mediaID] = true
+	}
+
+	var owned int
+	err := tx.QueryRowContext(ctx, `
+        SELECT COUNT(*) FROM media WHERE id = ANY($1) AND owner_id = $2 AND deleted_at = 0
+    `, pq.Array(mediaIDs), ownerID).Scan(&owned)
+	if err != nil {
+		return err
+	}
+	if owned != len(mediaIDs) {
+		return fmt.Errorf("media not found or not uploaded by user %s", ownerID)
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE %s = $1`, table, column), id); err != nil {
+		return err
+	}
+
+	insertQuery := fmt.Sprintf(`INSERT INTO %s (%s, media_id, position) VALUES ($1, $2, $3)`, table, column)
+	for i, mediaID := range mediaIDs {
+		if _, err := tx.ExecContext(ctx, insertQuery, id, mediaID, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	MediaStatusPending    = "pending"
+	MediaStatusProcessing = "processing"
+	MediaStatusReady      = "ready"
+	MediaStatusFailed     = "failed"
+)
+
+// PendingMedia is an upload claimed by the processing pipeline.
+type PendingMedia struct {
+	Id           string
+	StorageKey   string
+	ContentType  string
+	KeepLocation bool
+}
+
+type MediaRendition struct {
+	Name       string
+	StorageKey string
+	Url        string
+	Width      int
+	Height     int
+	Size       int64
+}
+
+// ProcessedMedia is the outcome of processing one upload.
+type ProcessedMedia struct {
+	Id            string
+	Size          int64
+	Checksum      string
+	Width         int
+	Height        int
+	DominantColor string
+	Renditions    []MediaRendition
+}
+
+// ClaimPendingMedia marks up to limit pending uploads as processing and
+// returns them. Uploads stuck in processing for longer than staleAfter, for
+// example because a worker died, are claimed again.
+func (c *ContentRepo) ClaimPendingMedia(ctx context.Context, limit int, staleAfter time.Duration) ([]PendingMedia, error) {
+	query := `
+        UPDATE media
+        SET status = 'processing', processing_started_at = CURRENT_TIMESTAMP
+        WHERE id IN (
+            SELECT id FROM media
+            WHERE deleted_at = 0
+              AND (status = 'pending'
+                   OR (status = 'processing'
+                       AND processing_started_at < CURRENT_TIMESTAMP - make_interval(secs => $2)))
+            ORDER BY created_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING id, storage_key, content_type, keep_location
+    `
+	rows, err := c.DB.QueryContext(ctx, query, limit, staleAfter.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pending []PendingMedia
+	for rows.Next() {
+		var media PendingMedia
+		if err := rows.Scan(&media.Id, &media.StorageKey, &media.ContentType, &media.KeepLocation); err != nil {
+			return nil, err
+		}
+		pending = append(pending, media)
+	}
+
+	return pending, rows.Err()
+}
+
+func (c *ContentRepo) CompleteMedia(ctx context.Context, media ProcessedMedia) error {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+        UPDATE media
+        SET status = 'ready', size_bytes = $2, checksum = $3, width = $4, height = $5, dominant_color = $6,
+            processing_error = '', processed_at = CURRENT_TIMESTAMP
+        WHERE id = $1
+    `
+	_, err = tx.ExecContext(ctx, query, media.Id, media.Size, media.Checksum, media.Width, media.Height, media.DominantColor)
+	if err != nil {
+		return err
+	}
+
+	renditionQuery := `
+        INSERT INTO media_renditions (media_id, name, storage_key, url, width, height, size_bytes)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (media_id, name) DO UPDATE
+        SET storage_key = EXCLUDED.storage_key, url = EXCLUDED.url, width = EXCLUDED.width,
+            height = EXCLUDED.height, size_bytes = EXCLUDED.size_bytes
+    `
+	for _, r := range media.Renditions {
+		_, err := tx.ExecContext(ctx, renditionQuery, media.Id, r.Name, r.StorageKey, r.Url, r.Width, r.Height, r.Size)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (c *ContentRepo) FailMedia(ctx context.Context, id, reason string) error {
+	query := `
+        UPDATE media
+        SET status = 'failed', processing_error = $2, processed_at = CURRENT_TIMESTAMP
+        WHERE id = $1
+    `
+	_, err := c.DB.ExecContext(ctx, query, id, reason)
+	return err
+}
@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	pb "content/genproto/content"
+	"content/pkg/mention"
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+const (
+	MentionSourceStory            = "story"
+	MentionSourceStoryComment     = "story_comment"
+	MentionSourceItineraryComment = "itinerary_comment"
+
+	MentionTargetStory     = "story"
+	MentionTargetItinerary = "itinerary"
+)
+
+// Mention is a user mentioned in a story or comment. The source is the text
+// the mention appears in, the target is the story or itinerary it belongs
+// to.
+type Mention struct {
+	Id              string
+	MentionedUserId string
+	AuthorId        string
+	SourceType      string
+	SourceId        string
+	TargetType      string
+	TargetId        string
+	CreatedAt       string
+}
+
+// saveMentions brings the mentions stored for a source in line with the
+// "@username" mentions in text. Usernames that do not resolve to a user and
+// authors mentioning themselves are ignored. Only the mentions that were not
+// stored before are returned, so an edit does not notify twice, and of those
+// only the ones whose users may read the target, as with StoryMentions.
+func saveMentions(ctx context.Context, tx *sql.Tx, source Mention, text string) ([]Mention, error) {
+	userIDs, err := queryIDs(ctx, tx, `
+        SELECT id FROM users
+        WHERE lower(username) = ANY($1) AND deleted_at = 0 AND id::text <> $2
+    `, pq.Array(mention.Parse(text)), source.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+	if userIDs == nil {
+		userIDs = []string{}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        DELETE FROM mentions
+        WHERE source_type = $1 AND source_id = $2 AND NOT (mentioned_user_id::text = ANY($3))
+    `, source.SourceType, source.SourceId, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+        INSERT INTO mentions (mentioned_user_id, author_id, source_type, source_id, target_type, target_id)
+        SELECT u, NULLIF($2, '')::uuid, $3, $4, $5, $6
+        FROM unnest($1::uuid[]) AS u
+        ON CONFLICT (source_type, source_id, mentioned_user_id) DO NOTHING
+        RETURNING id, mentioned_user_id, created_at
+    `, pq.Array(userIDs), source.AuthorId, source.SourceType, source.SourceId, source.TargetType, source.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mentions []Mention
+	for rows.Next() {
+		m := source
+		if err := rows.Scan(&m.Id, &m.MentionedUserId, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return readableMentions(ctx, tx, source, mentions)
+}
+
+// readableMentions keeps the mentions whose users may read the story or
+// itinerary source belongs to.
+func readableMentions(ctx context.Context, tx *sql.Tx, source Mention, mentions []Mention) ([]Mention, error) {
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+	userIDs := make([]string, len(mentions))
+	for i, m := range mentions {
+		userIDs[i] = m.MentionedUserId
+	}
+
+	query := `
+        SELECT u::text FROM unnest($2::uuid[]) AS u
+        JOIN stories s ON s.id::text = $1 AND s.deleted_at = 0
+        WHERE (s.status = 'published' AND s.is_hidden = false AND ` + visibleTo("s", "u::text") + `)
+           OR ` + storyAuthoredBy("s", "u::text")
+	if source.TargetType == MentionTargetItinerary {
+		query = `
+        SELECT u::text FROM unnest($2::uuid[]) AS u
+        JOIN itineraries i ON i.id::text = $1 AND i.deleted_at = 0
+        WHERE ` + visibleTo("i", "u::text")
+	}
+	readers, err := queryIDs(ctx, tx, query, source.TargetId, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	readable := make(map[string]bool, len(readers))
+	for _, id := range readers {
+		readable[id] = true
+	}
+	var res []Mention
+	for _, m := range mentions {
+		if readable[m.MentionedUserId] {
+			res = append(res, m)
+		}
+	}
+	return res, nil
+}
+
+// StoryMentions returns the users mentioned in a story's content who may
+// read the story.
+func (c *StoryRepo) StoryMentions(ctx context.Context, storyID string) ([]Mention, error) {
+	rows, err := c.DB.QueryContext(ctx, `
+        SELECT m.id, m.mentioned_user_id, COALESCE(m.author_id::text, ''), m.source_type, m.source_id,
+               m.target_type, m.target_id, m.created_at
+        FROM mentions m
+        JOIN stories s ON s.id = m.source_id
+        WHERE m.source_type = 'story' AND m.source_id = $1 AND `+storyVisibleTo("s", "m.mentioned_user_id::text")+`
+        ORDER BY m.created_at
+    `, storyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mentions []Mention
+	for rows.Next() {
+		var m Mention
+		err := rows.Scan(&m.Id, &m.MentionedUserId, &m.AuthorId, &m.SourceType, &m.SourceId,
+			&m.TargetType, &m.TargetId, &m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, m)
+	}
+
+	return mentions, rows.Err()
+}
+
+// mentionSources lists every text that can hold a mention and is still
+// visible: published stories and live comments on live content. Each text
+// carries the author and visibility of the story or itinerary it belongs to,
+// so it can be filtered with visibleTo.
+const mentionSources = `
+        SELECT 'story' AS type, s.id, s.content, s.author_id, s.visibility
+        FROM stories s
+        WHERE s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+        UNION ALL
+        SELECT 'story_comment', c.id, c.content, s.author_id, s.visibility
+        FROM comments c
+        JOIN stories s ON c.story_id = s.id
+        WHERE c.deleted_at = 0 AND c.is_hidden = false AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+        UNION ALL
+        SELECT 'itinerary_comment', c.id, c.content, i.author_id, i.visibility
+        FROM comment c
+        JOIN itineraries i ON c.itinerary_id = i.id
+        WHERE c.deleted_at = 0 AND c.is_hidden = false AND i.deleted_at = 0
+`
+
+// ListMentions lists where a user was mentioned, newest first.
+func (c *ContentRepo) ListMentions(ctx context.Context, req *pb.ListMentionsReq) (*pb.ListMentionsRes, error) {
+	from := `
+        FROM mentions m
+        JOIN (` + mentionSources + `) src ON src.type = m.source_type AND src.id = m.source_id
+        LEFT JOIN users u ON m.author_id = u.id
+        WHERE m.mentioned_user_id = $1 AND ` + visibleTo("src", "$1::text") + `
+    `
+
+	var total int64
+	err := c.DB.QueryRowContext(ctx, "SELECT COUNT(*)"+from, req.UserId).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT m.id, m.source_type, m.source_id, m.target_type, m.target_id, left(src.content, 200), m.created_at,
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, '')` + from + `
+        ORDER BY m.created_at DESC, m.id
+        LIMIT $2 OFFSET $3
+    `
+	rows, err := c.DB.QueryContext(ctx, query, req.UserId, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mentions []*pb.Mention
+	for rows.Next() {
+		var m pb.Mention
+		var author pb.Author
+
+		err := rows.Scan(
+			&m.Id,
+			&m.SourceType,
+			&m.SourceId,
+			&m.TargetType,
+			&m.TargetId,
+			&m.Excerpt,
+			&m.CreatedAt,
+			&author.UserId,
+			&author.Username,
+			&author.FullName,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		m.Author = &author
+		mentions = append(mentions, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ListMentionsRes{
+		Mentions: mentions,
+		Total:    total,
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+	}, nil
+}
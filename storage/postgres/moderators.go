@@ -0,0 +1,17 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+type rowQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func isModerator(ctx context.Context, q rowQueryer, userID string) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM moderators WHERE user_id = NULLIF($1, '')::uuid)`
+	err := q.QueryRowContext(ctx, query, userID).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	pb "content/genproto/content"
+	"content/pkg/geo"
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+const (
+	NearbyTypeStory       = "story"
+	NearbyTypeDestination = "destination"
+	NearbyTypeItinerary   = "itinerary"
+
+	MaxNearbyRadiusKm = 500
+)
+
+// FindNearby returns published stories, destinations and itineraries listed
+// to the caller within req.RadiusKm of a point, nearest first. Each source is prefiltered on its
+// indexed latitude/longitude with a bounding box before the exact haversine
+// distance is applied. An itinerary is listed once, at its nearest
+// destination.
+func (c *ContentRepo) FindNearby(ctx context.Context, req *pb.FindNearbyReq) (*pb.FindNearbyRes, error) {
+	if err := geo.Validate(req.Latitude, req.Longitude); err != nil {
+		return nil, err
+	}
+	if req.RadiusKm <= 0 || req.RadiusKm > MaxNearbyRadiusKm {
+		return nil, fmt.Errorf("radius_km must be between 0 and %d", MaxNearbyRadiusKm)
+	}
+
+	types := req.Types
+	if len(types) == 0 {
+		types = []string{NearbyTypeStory, NearbyTypeDestination, NearbyTypeItinerary}
+	}
+	for _, t := range types {
+		if t != NearbyTypeStory && t != NearbyTypeDestination && t != NearbyTypeItinerary {
+			return nil, fmt.Errorf("unknown place type: %q", t)
+		}
+	}
+
+	box := geo.BoundingBox(req.Latitude, req.Longitude, req.RadiusKm)
+
+	query := `
+        SELECT type, id, title, latitude, longitude, COALESCE(place_name, ''), distance
+        FROM (
+            SELECT 'story' AS type, s.id::text AS id, s.title, s.latitude, s.longitude, s.place_name,
+                   geo_distance_km($1, $2, s.latitude, s.longitude) AS distance
+            FROM stories s
+            WHERE 'story' = ANY($8) AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+              AND ` + storyListedTo("s", "$10") + `
+              AND s.latitude BETWEEN $4 AND $5 AND s.longitude BETWEEN $6 AND $7
+
+            UNION ALL
+
+            SELECT 'destination', d.id::text, d.name, d.latitude, d.longitude, d.place_name,
+                   geo_distance_km($1, $2, d.latitude, d.longitude)
+            FROM destinations d
+            WHERE 'destination' = ANY($8) AND d.deleted_at = 0
+              AND d.latitude BETWEEN $4 AND $5 AND d.longitude BETWEEN $6 AND $7
+
+            UNION ALL
+
+            SELECT * FROM (
+                SELECT DISTINCT ON (i.id) 'itinerary', i.id::text, i.title, d.latitude, d.longitude,
+                       COALESCE(d.place_name, d.name), geo_distance_km($1, $2, d.latitude, d.longitude) AS distance
+                FROM itinerary_destinations d
+                JOIN itineraries i ON d.itinerary_id = i.id
+                WHERE 'itinerary' = ANY($8) AND i.deleted_at = 0 AND ` + listedTo("i", "$10") + `
+                  AND d.latitude BETWEEN $4 AND $5 AND d.longitude BETWEEN $6 AND $7
+                ORDER BY i.id, distance
+            ) nearest
+        ) places
+        WHERE distance <= $3
+        ORDER BY distance
+        LIMIT $9
+    `
+
+	rows, err := c.DB.QueryContext(ctx, query, req.Latitude, req.Longitude, req.RadiusKm,
+		box.MinLat, box.MaxLat, box.MinLng, box.MaxLng, pq.Array(types), req.Limit, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find nearby places: %v", err)
+	}
+	defer rows.Close()
+
+	var res pb.FindNearbyRes
+	for rows.Next() {
+		var place pb.NearbyPlace
+		var point pb.GeoPoint
+		err := rows.Scan(&place.Type, &place.Id, &place.Title, &point.Latitude, &point.Longitude,
+			&point.PlaceName, &place.DistanceKm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan nearby place: %v", err)
+		}
+		place.Geo = &point
+		res.Places = append(res.Places, &place)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func contentGeoPoint(g geoColumns) *pb.GeoPoint {
+	if !g.Latitude.Valid {
+		return nil
+	}
+	return &pb.GeoPoint{
+		Latitude:  g.Latitude.Float64,
+		Longitude: g.Longitude.Float64,
+		PlaceName: g.PlaceName.String,
+	}
+}
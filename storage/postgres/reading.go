@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"content/pkg/markdown"
+	"content/pkg/reading"
+	"context"
+)
+
+// StoryExcerptLength is the length of story excerpts in characters.
+const StoryExcerptLength = 280
+
+// readingStatsVersion is stored in stories.reading_stats_version. The
+// statistics are computed from the rendered content, so they go stale when
+// either the reading package or the renderer changes.
+const readingStatsVersion = reading.Version*1000 + markdown.Version
+
+type readingStats struct {
+	words   int
+	minutes int
+	excerpt string
+}
+
+// storyReadingStats computes the reading statistics of a story from the
+// plain text of its rendered content, so Markdown syntax is neither counted
+// nor shown in the excerpt.
+func storyReadingStats(contentHTML string) readingStats {
+	text := markdown.TextFromHTML(contentHTML)
+	stats := reading.Compute(text)
+	return readingStats{
+		words:   stats.Words,
+		minutes: stats.Minutes,
+		excerpt: reading.Excerpt(text, StoryExcerptLength),
+	}
+}
+
+// BackfillReadingStats computes the reading statistics of up to limit live
+// stories that have none yet or were computed by an older version of the
+// reading package or the renderer, rendering their content again on the way.
+// It returns how many stories it looked at, so callers can stop once that
+// falls below limit.
+func (c *StoryRepo) BackfillReadingStats(ctx context.Context, limit int) (int, error) {
+	rows, err := c.DB.QueryContext(ctx, `
+        SELECT id, content, content_format FROM stories
+        WHERE reading_stats_version < $1 AND deleted_at = 0
+        LIMIT $2
+    `, readingStatsVersion, limit)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	type story struct{ id, content, format string }
+	var stories []story
+	for rows.Next() {
+		var s story
+		if err := rows.Scan(&s.id, &s.content, &s.format); err != nil {
+			return 0, err
+		}
+		stories = append(stories, s)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	rows.Close()
+
+	// A story edited in the meantime already has fresh statistics.
+	query := `
+        UPDATE stories
+        SET word_count = $2, reading_minutes = $3, excerpt = $4, reading_stats_version = $5,
+            content_html = $8, content_html_version = $9
+        WHERE id = $1 AND content = $6 AND content_format = $7
+    `
+	for _, s := range stories {
+		html := renderContent(s.format, s.content)
+		stats := storyReadingStats(html)
+		_, err := c.DB.ExecContext(ctx, query, s.id, stats.words, stats.minutes, stats.excerpt, readingStatsVersion, s.content, s.format,
+			html, markdown.Version)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(stories), nil
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"content/pkg/related"
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// RelatedCandidates returns what other stories have in common with a story:
+// shared tags, shared likers, the same location or place and the same
+// author. Only published stories that can show up in other users' listings
+// are considered, at most limit of them, those sharing the most tags and
+// likers first.
+func (c *StoryRepo) RelatedCandidates(ctx context.Context, storyID string, limit int) (map[string]related.Signals, error) {
+	query := `
+        WITH src AS (
+            SELECT s.id, s.author_id, s.likes_count,
+                   lower(trim(COALESCE(s.location, ''))) AS location,
+                   lower(trim(COALESCE(s.place_name, ''))) AS place_name,
+                   (SELECT COUNT(*) FROM story_tags t WHERE t.story_id = s.id) AS tags_count
+            FROM stories s
+            WHERE s.id = $1
+        ),
+        shared_tags AS (
+            SELECT t2.story_id, COUNT(*) AS shared
+            FROM story_tags t1
+            JOIN story_tags t2 ON t2.tag = t1.tag AND t2.story_id <> t1.story_id
+            WHERE t1.story_id = $1
+            GROUP BY t2.story_id
+        ),
+        shared_likers AS (
+            SELECT l2.story_id, COUNT(*) AS shared
+            FROM likes l1
+            JOIN likes l2 ON l2.user_id = l1.user_id AND l2.story_id <> l1.story_id
+            WHERE l1.story_id = $1
+            GROUP BY l2.story_id
+        ),
+        candidates AS (
+            SELECT s.id, s.likes_count, s.published_at,
+                   COALESCE(st.shared, 0) AS shared_tags,
+                   COALESCE(sl.shared, 0) AS shared_likers,
+                   (src.location <> '' AND lower(trim(COALESCE(s.location, ''))) = src.location)
+                       OR (src.place_name <> '' AND lower(trim(COALESCE(s.place_name, ''))) = src.place_name) AS same_location,
+                   COALESCE(s.author_id = src.author_id, false) AS same_author
+            FROM stories s
+            CROSS JOIN src
+            LEFT JOIN shared_tags st ON st.story_id = s.id
+            LEFT JOIN shared_likers sl ON sl.story_id = s.id
+            WHERE s.id <> src.id AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+              AND s.visibility IN ('public', 'followers')
+        )
+        SELECT c.id, c.shared_tags, src.tags_count,
+               (SELECT COUNT(*) FROM story_tags t WHERE t.story_id = c.id),
+               c.shared_likers, COALESCE(src.likes_count, 0), COALESCE(c.likes_count, 0),
+               c.same_location, c.same_author
+        FROM candidates c
+        CROSS JOIN src
+        WHERE c.shared_tags > 0 OR c.shared_likers > 0 OR c.same_location OR c.same_author
+        ORDER BY c.shared_tags + c.shared_likers DESC, c.published_at DESC
+        LIMIT $2
+    `
+	rows, err := c.DB.QueryContext(ctx, query, storyID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	candidates := make(map[string]related.Signals)
+	for rows.Next() {
+		var id string
+		var s related.Signals
+		err := rows.Scan(&id, &s.SharedTags, &s.Tags, &s.CandidateTags,
+			&s.SharedLikers, &s.Likes, &s.CandidateLikes, &s.SameLocation, &s.SameAuthor)
+		if err != nil {
+			return nil, err
+		}
+		candidates[id] = s
+	}
+
+	return candidates, rows.Err()
+}
+
+// GetRelatedStories loads the ranked related stories, keeping the order of
+// ids. Stories the caller cannot list, wrote or already liked are skipped.
+func (c *StoryRepo) GetRelatedStories(ctx context.Context, ids []string, req *pb.GetRelatedStoriesReq) (*pb.GetAllStoriesRes, error) {
+	query := `
+        SELECT s.id, s.title, COALESCE(s.location, ''), s.likes_count, s.comments_count, s.views_count,
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, ''),
+               s.visibility, s.word_count, s.reading_minutes, s.excerpt
+        FROM stories s
+        LEFT JOIN users u ON s.author_id = u.id
+        WHERE s.id::text = ANY($1) AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+          AND ` + storyListedTo("s", "$2") + `
+          AND NOT ` + storyAuthoredBy("s", "$2") + `
+          AND NOT EXISTS (SELECT 1 FROM likes l WHERE l.story_id = s.id AND l.user_id = NULLIF($2, '')::uuid)
+        ORDER BY array_position($1, s.id::text)
+        LIMIT $3
+    `
+	rows, err := c.DB.QueryContext(ctx, query, pq.Array(ids), req.UserId, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stories []*pb.Stories
+	for rows.Next() {
+		var story pb.Stories
+		var author pb.Author
+
+		err := rows.Scan(
+			&story.StoryId,
+			&story.Title,
+			&story.Location,
+			&story.LikesCount,
+			&story.CommentsCount,
+			&story.ViewsCount,
+			&author.UserId,
+			&author.Username,
+			&author.FullName,
+			&story.Visibility,
+			&story.WordCount,
+			&story.ReadingMinutes,
+			&story.Excerpt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		story.Author = &author
+		stories = append(stories, &story)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := c.attachCoAuthors(ctx, stories); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetAllStoriesRes{
+		Stories: stories,
+		Total:   int64(len(stories)),
+		Limit:   req.Limit,
+	}, nil
+}
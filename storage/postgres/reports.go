@@ -0,0 +1,637 @@
+package postgres
+
+import (
+	pb "content/genproto/content"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+const (
+	ReportTypeStory            = "story"
+	ReportTypeStoryComment     = "story_comment"
+	ReportTypeItineraryComment = "itinerary_comment"
+	ReportTypeTip              = "tip"
+	ReportTypeMessage          = "message"
+
+	ReportStatusOpen      = "open"
+	ReportStatusResolved  = "resolved"
+	ReportStatusDismissed = "dismissed"
+
+	ModerationAutoHide = "auto_hide"
+	ModerationDismiss  = "dismiss"
+	ModerationHide     = "hide"
+	ModerationDelete   = "delete"
+	ModerationWarn     = "warn"
+)
+
+var reportReasons = map[string]bool{
+	"spam":           true,
+	"harassment":     true,
+	"hate":           true,
+	"violence":       true,
+	"sexual":         true,
+	"misinformation": true,
+	"copyright":      true,
+	"other":          true,
+}
+
+// reportedTables maps every reportable entity type to its table, the column
+// holding its author and the condition under which it is still visible.
+var reportedTables = map[string]struct{ table, author, live string }{
+	ReportTypeStory:            {"stories", "author_id", "deleted_at = 0 AND status = 'published'"},
+	ReportTypeStoryComment:     {"comments", "author_id", "deleted_at = 0"},
+	ReportTypeItineraryComment: {"comment", "author_id", "deleted_at = 0"},
+	ReportTypeTip:              {"travel_tips", "author_id", "TRUE"},
+	ReportTypeMessage:          {"messages", "sender_id", "TRUE"},
+}
+
+func validateReportType(entityType string) error {
+	if _, ok := reportedTables[entityType]; !ok {
+		return fmt.Errorf("invalid report entity type %q", entityType)
+	}
+	return nil
+}
+
+// reportedEntity returns the author of a reported entity and whether it is
+// still live. found is false when the entity does not exist at all. The
+// entity stays locked until tx ends, so concurrent reports and decisions on
+// it are counted and applied one at a time.
+func reportedEntity(ctx context.Context, tx *sql.Tx, entityType, entityID string) (authorID string, live, found bool, err error) {
+	t := reportedTables[entityType]
+	query := fmt.Sprintf(`SELECT COALESCE(%s::text, ''), %s FROM %s WHERE id::text = $1 FOR UPDATE`, t.author, t.live, t.table)
+	err = tx.QueryRowContext(ctx, query, entityID).Scan(&authorID, &live)
+	if err == sql.ErrNoRows {
+		return "", false, false, nil
+	}
+	if err != nil {
+		return "", false, false, err
+	}
+
+	return authorID, live, true, nil
+}
+
+// setHidden hides or shows an entity and reports whether anything changed.
+func setHidden(ctx context.Context, tx *sql.Tx, entityType, entityID string, hidden bool) (bool, error) {
+	query := fmt.Sprintf(`UPDATE %s SET is_hidden = $2 WHERE id::text = $1 AND is_hidden <> $2`, reportedTables[entityType].table)
+	res, err := tx.ExecContext(ctx, query, entityID, hidden)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func recordModerationAction(ctx context.Context, tx *sql.Tx, action *pb.ModerationAction) (*pb.ModerationAction, error) {
+	err := tx.QueryRowContext(ctx, `
+        INSERT INTO moderation_actions (moderator_id, entity_type, entity_id, target_user_id, action, note)
+        VALUES (NULLIF($1, '')::uuid, $2, $3, NULLIF($4, '')::uuid, $5, $6)
+        RETURNING id, created_at
+    `, action.ModeratorId, action.EntityType, action.EntityId, action.TargetUserId, action.Action, action.Note).Scan(
+		&action.Id, &action.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+const reportColumns = `
+        r.id, r.entity_type, r.entity_id, COALESCE(r.reporter_id::text, ''), r.reason, r.details, r.status,
+        r.created_at, COALESCE(r.resolved_at::text, ''), COALESCE(r.resolved_by::text, ''), COALESCE(r.resolution, ''),
+        (SELECT COUNT(*) FROM reports o
+         WHERE o.entity_type = r.entity_type AND o.entity_id = r.entity_id AND o.status = 'open'),
+        COALESCE(CASE r.entity_type
+            WHEN 'story' THEN (SELECT is_hidden FROM stories WHERE id = r.entity_id)
+            WHEN 'story_comment' THEN (SELECT is_hidden FROM comments WHERE id = r.entity_id)
+            WHEN 'itinerary_comment' THEN (SELECT is_hidden FROM comment WHERE id = r.entity_id)
+            WHEN 'tip' THEN (SELECT is_hidden FROM travel_tips WHERE id = r.entity_id)
+            WHEN 'message' THEN (SELECT is_hidden FROM messages WHERE id = r.entity_id)
+        END, FALSE)
+`
+
+func scanReport(row interface{ Scan(...interface{}) error }) (*pb.Report, error) {
+	var report pb.Report
+	err := row.Scan(
+		&report.Id,
+		&report.EntityType,
+		&report.EntityId,
+		&report.ReporterId,
+		&report.Reason,
+		&report.Details,
+		&report.Status,
+		&report.CreatedAt,
+		&report.ResolvedAt,
+		&report.ResolvedBy,
+		&report.Resolution,
+		&report.OpenReports,
+		&report.EntityHidden,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// ReportContent files a report against a story, comment, tip or message.
+// Reporting the same entity again while the first report is open returns
+// the open report. Once autoHideThreshold distinct users have open reports
+// against an entity it is hidden until a moderator decides; a threshold of
+// zero turns automatic hiding off.
+func (c *ContentRepo) ReportContent(ctx context.Context, req *pb.ReportContentReq, autoHideThreshold int64) (*pb.Report, error) {
+	if err := validateReportType(req.EntityType); err != nil {
+		return nil, err
+	}
+	if !reportReasons[req.Reason] {
+		return nil, fmt.Errorf("invalid report reason %q", req.Reason)
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	authorID, live, found, err := reportedEntity(ctx, tx, req.EntityType, req.EntityId)
+	if err != nil {
+		return nil, err
+	}
+	if !found || !live {
+		return nil, fmt.Errorf("%s %s not found", req.EntityType, req.EntityId)
+	}
+	if req.EntityType == ReportTypeStory {
+		if err := storyViewable(ctx, tx, req.EntityId, req.UserId); err != nil {
+			return nil, err
+		}
+	}
+	if authorID == req.UserId {
+		return nil, fmt.Errorf("users cannot report their own content")
+	}
+	if req.EntityType == ReportTypeMessage {
+		var recipient bool
+		err := tx.QueryRowContext(ctx, `
+            SELECT EXISTS (SELECT 1 FROM messages WHERE id::text = $1 AND recipient_id = NULLIF($2, '')::uuid)
+        `, req.EntityId, req.UserId).Scan(&recipient)
+		if err != nil {
+			return nil, err
+		}
+		if !recipient {
+			return nil, fmt.Errorf("only the recipient can report message %s", req.EntityId)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO reports (entity_type, entity_id, reporter_id, reason, details)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (entity_type, entity_id, reporter_id) WHERE status = 'open' DO NOTHING
+    `, req.EntityType, req.EntityId, req.UserId, req.Reason, req.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	var reporters int64
+	err = tx.QueryRowContext(ctx, `
+        SELECT COUNT(DISTINCT reporter_id) FROM reports
+        WHERE entity_type = $1 AND entity_id = $2 AND status = 'open'
+    `, req.EntityType, req.EntityId).Scan(&reporters)
+	if err != nil {
+		return nil, err
+	}
+
+	if autoHideThreshold > 0 && reporters >= autoHideThreshold {
+		hidden, err := setHidden(ctx, tx, req.EntityType, req.EntityId, true)
+		if err != nil {
+			return nil, err
+		}
+		if hidden {
+			_, err := recordModerationAction(ctx, tx, &pb.ModerationAction{
+				EntityType:   req.EntityType,
+				EntityId:     req.EntityId,
+				TargetUserId: authorID,
+				Action:       ModerationAutoHide,
+				Note:         fmt.Sprintf("reported by %d users", reporters),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	report, err := scanReport(tx.QueryRowContext(ctx, `
+        SELECT `+reportColumns+`
+        FROM reports r
+        WHERE r.entity_type = $1 AND r.entity_id = $2 AND r.reporter_id = $3 AND r.status = 'open'
+    `, req.EntityType, req.EntityId, req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// ListReports lists the moderation queue, oldest first. An empty status
+// lists the open reports.
+func (c *ContentRepo) ListReports(ctx context.Context, req *pb.ListReportsReq) (*pb.ListReportsRes, error) {
+	moderator, err := isModerator(ctx, c.DB, req.ModeratorId)
+	if err != nil {
+		return nil, err
+	}
+	if !moderator {
+		return nil, fmt.Errorf("user %s is not a moderator", req.ModeratorId)
+	}
+
+	status := req.Status
+	if status == "" {
+		status = ReportStatusOpen
+	}
+
+	queryParams := []interface{}{status}
+	conditions := []string{"r.status = $1"}
+	n := 2
+	if req.EntityType != "" {
+		if err := validateReportType(req.EntityType); err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, fmt.Sprintf("r.entity_type = $%d", n))
+		queryParams = append(queryParams, req.EntityType)
+		n++
+	}
+
+	from := ` FROM reports r WHERE ` + strings.Join(conditions, " AND ")
+
+	var total int64
+	err = c.DB.QueryRowContext(ctx, "SELECT COUNT(*)"+from, queryParams...).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + reportColumns + from + fmt.Sprintf(" ORDER BY r.created_at, r.id LIMIT $%d OFFSET $%d", n, n+1)
+	queryParams = append(queryParams, req.Limit, req.Offset)
+
+	rows, err := c.DB.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []*pb.Report
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ListReportsRes{
+		Reports: reports,
+		Total:   total,
+		Offset:  req.Offset,
+		Limit:   req.Limit,
+	}, nil
+}
+
+// ResolveReport applies a moderator's decision to the reported entity and
+// closes every open report against it. dismiss leaves the content up and
+// undoes an automatic hide, hide and delete take it down, and warn keeps it
+// but records a warning for its author. Every decision is recorded in
+// moderation_actions. Content that was held for review when it was created
+// and is let through by the dismissal is returned, so it can be announced.
+func (c *ContentRepo) ResolveReport(ctx context.Context, req *pb.ResolveReportReq) (*pb.ResolveReportRes, *Released, error) {
+	switch req.Action {
+	case ModerationDismiss, ModerationHide, ModerationDelete, ModerationWarn:
+	default:
+		return nil, nil, fmt.Errorf("invalid moderation action %q", req.Action)
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	moderator, err := isModerator(ctx, tx, req.ModeratorId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !moderator {
+		return nil, nil, fmt.Errorf("user %s is not a moderator", req.ModeratorId)
+	}
+
+	var entityType, entityID, status string
+	err = tx.QueryRowContext(ctx, `
+        SELECT entity_type, entity_id, status FROM reports WHERE id = $1 FOR UPDATE
+    `, req.ReportId).Scan(&entityType, &entityID, &status)
+	if err == sql.ErrNoRows {
+		return nil, nil, fmt.Errorf("report %s not found", req.ReportId)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if status != ReportStatusOpen {
+		return nil, nil, fmt.Errorf("report %s is already %s", req.ReportId, status)
+	}
+
+	authorID, _, _, err := reportedEntity(ctx, tx, entityType, entityID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolution := ReportStatusResolved
+	released := &Released{}
+	switch req.Action {
+	case ModerationDismiss:
+		resolution = ReportStatusDismissed
+		var unhidden bool
+		unhidden, err = unhideAutoHidden(ctx, tx, entityType, entityID)
+		if err != nil || !unhidden {
+			break
+		}
+		switch entityType {
+		case ReportTypeStory:
+			released.Story, err = releaseHeldStory(ctx, tx, entityID)
+		case ReportTypeStoryComment, ReportTypeItineraryComment:
+			released.Mentions, err = releaseHeldComment(ctx, tx, entityType, entityID)
+		}
+	case ModerationHide:
+		_, err = setHidden(ctx, tx, entityType, entityID, true)
+	case ModerationDelete:
+		err = deleteReported(ctx, tx, entityType, entityID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+        UPDATE reports
+        SET status = $3, resolved_at = CURRENT_TIMESTAMP, resolved_by = $4, resolution = $5
+        WHERE entity_type = $1 AND entity_id = $2 AND status = 'open'
+        RETURNING id
+    `, entityType, entityID, resolution, req.ModeratorId, req.Action)
+	if err != nil {
+		return nil, nil, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	action, err := recordModerationAction(ctx, tx, &pb.ModerationAction{
+		ModeratorId:  req.ModeratorId,
+		EntityType:   entityType,
+		EntityId:     entityID,
+		TargetUserId: authorID,
+		Action:       req.Action,
+		Note:         req.Note,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &pb.ResolveReportRes{Action: action}
+	for _, id := range ids {
+		report, err := scanReport(tx.QueryRowContext(ctx, `SELECT `+reportColumns+` FROM reports r WHERE r.id = $1`, id))
+		if err != nil {
+			return nil, nil, err
+		}
+		res.Reports = append(res.Reports, report)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return res, released, nil
+}
+
+// unhideAutoHidden shows an entity again if it was hidden by the report
+// threshold or the moderation filters rather than by a moderator, and
+// reports whether it did.
+func unhideAutoHidden(ctx context.Context, tx *sql.Tx, entityType, entityID string) (bool, error) {
+	var last string
+	err := tx.QueryRowContext(ctx, `
+        SELECT action FROM moderation_actions
+        WHERE entity_type = $1 AND entity_id = $2 AND action IN ('auto_hide', 'hide')
+        ORDER BY created_at DESC
+        LIMIT 1
+    `, entityType, entityID).Scan(&last)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if last != ModerationAutoHide {
+		return false, nil
+	}
+
+	return setHidden(ctx, tx, entityType, entityID, false)
+}
+
+// Released is what a dismissal lets through that was held for review when it
+// was created: a story that is published now, or the mentions in a comment
+// that were not announced while it was held.
+type Released struct {
+	Story    *PublishedStory
+	Mentions []Mention
+}
+
+// releaseHeldStory publishes a story that was held for review when it was
+// created and has just been shown. It returns nil for stories that are not
+// published yet or were published before they were hidden.
+func releaseHeldStory(ctx context.Context, tx *sql.Tx, storyID string) (*PublishedStory, error) {
+	var story PublishedStory
+	err := tx.QueryRowContext(ctx, `
+        UPDATE stories SET published_at = CURRENT_TIMESTAMP
+        WHERE id = $1 AND status = 'published' AND published_at IS NULL AND deleted_at = 0
+        RETURNING id, COALESCE(author_id::text, ''), title, published_at
+    `, storyID).Scan(&story.Id, &story.AuthorId, &story.Title, &story.PublishedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &story, nil
+}
+
+// releaseHeldComment returns the mentions of a comment that was held for
+// review when it was created and has just been shown, keeping the ones whose
+// users may read what the comment belongs to. A comment that was dismissed
+// before had its mentions announced then and returns none.
+func releaseHeldComment(ctx context.Context, tx *sql.Tx, entityType, commentID string) ([]Mention, error) {
+	var held bool
+	err := tx.QueryRowContext(ctx, `
+        SELECT COALESCE((SELECT action = 'auto_hide' AND note LIKE $3 || '%'
+                         FROM moderation_actions
+                         WHERE entity_type = $1 AND entity_id = $2
+                         ORDER BY created_at
+                         LIMIT 1), false)
+           AND NOT EXISTS (SELECT 1 FROM moderation_actions
+                           WHERE entity_type = $1 AND entity_id = $2 AND action = 'dismiss')
+    `, entityType, commentID, heldNote).Scan(&held)
+	if err != nil || !held {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+        SELECT id, mentioned_user_id, COALESCE(author_id::text, ''), source_type, source_id,
+               target_type, target_id, created_at
+        FROM mentions
+        WHERE source_type = $1 AND source_id = $2
+        ORDER BY created_at
+    `, entityType, commentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mentions []Mention
+	for rows.Next() {
+		var m Mention
+		err := rows.Scan(&m.Id, &m.MentionedUserId, &m.AuthorId, &m.SourceType, &m.SourceId,
+			&m.TargetType, &m.TargetId, &m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+
+	return readableMentions(ctx, tx, mentions[0], mentions)
+}
+
+// deleteReported takes reported content down for good. Stories and comments
+// are soft-deleted and their counters adjusted the way their authors'
+// deletes do; tips and messages have no soft delete and are removed.
+func deleteReported(ctx context.Context, tx *sql.Tx, entityType, entityID string) error {
+	switch entityType {
+	case ReportTypeStory:
+		_, err := tx.ExecContext(ctx, `
+            UPDATE stories
+            SET deleted_at = date_part('epoch', current_timestamp)::INT
+            WHERE id = $1 AND deleted_at = 0
+        `, entityID)
+		if err != nil {
+			return err
+		}
+		return deleteBookmarks(ctx, tx, BookmarkTypeStory, entityID)
+
+	case ReportTypeStoryComment:
+		var storyID, parentID string
+		err := tx.QueryRowContext(ctx, `
+            UPDATE comments
+            SET deleted_at = date_part('epoch', current_timestamp)::INT
+            WHERE id = $1 AND deleted_at = 0
+            RETURNING story_id, COALESCE(parent_comment_id::text, '')
+        `, entityID).Scan(&storyID, &parentID)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if parentID != "" {
+			_, err := tx.ExecContext(ctx, `
+                UPDATE comments SET replies_count = GREATEST(replies_count - 1, 0) WHERE id = $1
+            `, parentID)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.ExecContext(ctx, `
+            UPDATE stories SET comments_count = GREATEST(comments_count - 1, 0) WHERE id = $1
+        `, storyID)
+		return err
+
+	case ReportTypeItineraryComment:
+		var itineraryID string
+		err := tx.QueryRowContext(ctx, `
+            UPDATE comment
+            SET deleted_at = date_part('epoch', current_timestamp)::INT
+            WHERE id = $1 AND deleted_at = 0
+            RETURNING itinerary_id
+        `, entityID).Scan(&itineraryID)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+            UPDATE itineraries SET comments_count = GREATEST(comments_count - 1, 0) WHERE id = $1
+        `, itineraryID)
+		return err
+
+	case ReportTypeTip:
+		if err := deleteBookmarks(ctx, tx, BookmarkTypeTip, entityID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `DELETE FROM travel_tips WHERE id = $1`, entityID)
+		return err
+
+	case ReportTypeMessage:
+		_, err := tx.ExecContext(ctx, `DELETE FROM messages WHERE id = $1`, entityID)
+		return err
+	}
+
+	return fmt.Errorf("invalid report entity type %q", entityType)
+}
+
+// Hold asks for content to be held for review as it is created. Held
+// content is stored hidden and filed in the moderation queue as a report
+// without a reporter, in the transaction that creates it. The hide is
+// recorded as automatic, so dismissing the report publishes the content.
+type Hold struct {
+	Reasons []string
+}
+
+// heldNote starts the note of the moderation action that hides held content.
+const heldNote = "held by moderation filters: "
+
+func fileHold(ctx context.Context, tx *sql.Tx, hold *Hold, entityType, entityID, authorID string) error {
+	if hold == nil {
+		return nil
+	}
+
+	details := strings.Join(hold.Reasons, "; ")
+	_, err := tx.ExecContext(ctx, `
+        INSERT INTO reports (entity_type, entity_id, reason, details)
+        VALUES ($1, $2, 'spam', $3)
+    `, entityType, entityID, details)
+	if err != nil {
+		return err
+	}
+
+	_, err = recordModerationAction(ctx, tx, &pb.ModerationAction{
+		EntityType:   entityType,
+		EntityId:     entityID,
+		TargetUserId: authorID,
+		Action:       ModerationAutoHide,
+		Note:         heldNote + details,
+	})
+	return err
+}
@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"content/pkg/diff"
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// storyVersions lists every version of a live story: the archived revisions
+// plus the current one, which only lives in the stories table.
+const storyVersions = `
+        SELECT r.story_id, r.revision, r.title, r.content, r.content_format, r.editor_id, r.created_at
+        FROM story_revisions r
+        JOIN stories s ON r.story_id = s.id
+        WHERE r.story_id = $1 AND s.deleted_at = 0
+        UNION ALL
+        SELECT id, revision, title, content, content_format, COALESCE(updated_by, author_id), updated_at
+        FROM stories
+        WHERE id = $1 AND deleted_at = 0
+`
+
+const revisionColumns = `
+        v.story_id, v.revision, v.title, v.content, v.content_format, v.created_at,
+        COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, '')
+`
+
+func scanRevision(row interface{ Scan(...interface{}) error }) (*pb.StoryRevision, error) {
+	var revision pb.StoryRevision
+	var editor pb.Author
+
+	err := row.Scan(
+		&revision.StoryId,
+		&revision.Revision,
+		&revision.Title,
+		&revision.Content,
+		&revision.ContentFormat,
+		&revision.CreatedAt,
+		&editor.UserId,
+		&editor.Username,
+		&editor.FullName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revision.Editor = &editor
+	return &revision, nil
+}
+
+// ListStoryRevisions lists the previous versions of a story, newest first.
+// The current version is not part of the list; its number is returned as
+// CurrentRevision.
+func (c *StoryRepo) ListStoryRevisions(ctx context.Context, req *pb.ListStoryRevisionsReq) (*pb.ListStoryRevisionsRes, error) {
+	if err := storyViewable(ctx, c.DB, req.StoryId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	var current int64
+	err := c.DB.QueryRowContext(ctx, `
+        SELECT revision FROM stories WHERE id = $1 AND deleted_at = 0
+    `, req.StoryId).Scan(&current)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("story %s not found", req.StoryId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT ` + revisionColumns + `
+        FROM (` + storyVersions + `) v
+        LEFT JOIN users u ON v.editor_id = u.id
+        WHERE v.revision < $2
+        ORDER BY v.revision DESC
+        LIMIT $3 OFFSET $4
+    `
+	rows, err := c.DB.QueryContext(ctx, query, req.StoryId, current, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*pb.StoryRevision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var total int64
+	err = c.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM story_revisions WHERE story_id = $1`, req.StoryId).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListStoryRevisionsRes{
+		Revisions:       revisions,
+		CurrentRevision: current,
+		Total:           total,
+		Offset:          req.Offset,
+		Limit:           req.Limit,
+	}, nil
+}
+
+// GetStoryRevision returns one version of a story. Revision zero stands for the
+// current version.
+func (c *StoryRepo) GetStoryRevision(ctx context.Context, req *pb.GetStoryRevisionReq) (*pb.StoryRevision, error) {
+	if err := storyViewable(ctx, c.DB, req.StoryId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT ` + revisionColumns + `
+        FROM (` + storyVersions + `) v
+        LEFT JOIN users u ON v.editor_id = u.id
+        WHERE $2 = 0 OR v.revision = $2
+        ORDER BY v.revision DESC
+        LIMIT 1
+    `
+	revision, err := scanRevision(c.DB.QueryRowContext(ctx, query, req.StoryId, req.Revision))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("revision %d of story %s not found", req.Revision, req.StoryId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
+
+// DiffStoryRevisions compares the content of two versions of a story line by
+// line. A zero ToRevision compares against the current version.
+func (c *StoryRepo) DiffStoryRevisions(ctx context.Context, req *pb.DiffStoryRevisionsReq) (*pb.DiffStoryRevisionsRes, error) {
+	if req.FromRevision <= 0 {
+		return nil, fmt.Errorf("from_revision must be positive")
+	}
+
+	from, err := c.GetStoryRevision(ctx, &pb.GetStoryRevisionReq{StoryId: req.StoryId, Revision: req.FromRevision, UserId: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+	to, err := c.GetStoryRevision(ctx, &pb.GetStoryRevisionReq{StoryId: req.StoryId, Revision: req.ToRevision, UserId: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.DiffStoryRevisionsRes{
+		StoryId:      req.StoryId,
+		FromRevision: from.Revision,
+		ToRevision:   to.Revision,
+		OldTitle:     from.Title,
+		NewTitle:     to.Title,
+	}
+	for _, line := range diff.Lines(from.Content, to.Content) {
+		res.Lines = append(res.Lines, &pb.DiffLine{
+			Op:      line.Op,
+			Text:    line.Text,
+			OldLine: int64(line.OldLine),
+			NewLine: int64(line.NewLine),
+		})
+	}
+
+	return res, nil
+}
+
+// RevertStory restores the title, content and format of an earlier
+// revision. The revert is itself recorded as a new revision, so nothing is
+// lost.
+func (c *StoryRepo) RevertStory(ctx context.Context, req *pb.RevertStoryReq) (*pb.UpdateStoriesRes, []Mention, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	var isAuthor bool
+	err = tx.QueryRowContext(ctx, `
+        SELECT COALESCE(author_id = NULLIF($2, '')::uuid, false)
+        FROM stories WHERE id = $1 AND deleted_at = 0 FOR UPDATE
+    `, req.StoryId, req.UserId).Scan(&isAuthor)
+	if err == sql.ErrNoRows {
+		return nil, nil, fmt.Errorf("story %s not found", req.StoryId)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isAuthor {
+		return nil, nil, fmt.Errorf("user %s is not the author of story %s", req.UserId, req.StoryId)
+	}
+
+	var title, content, format string
+	err = tx.QueryRowContext(ctx, `
+        SELECT title, content, content_format FROM story_revisions WHERE story_id = $1 AND revision = $2
+    `, req.StoryId, req.Revision).Scan(&title, &content, &format)
+	if err == sql.ErrNoRows {
+		return nil, nil, fmt.Errorf("revision %d of story %s not found", req.Revision, req.StoryId)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedStory, mentions, err := c.updateStory(ctx, tx, &pb.UpdateStoriesReq{
+		Id:            req.StoryId,
+		Title:         title,
+		Content:       content,
+		UserId:        req.UserId,
+		ContentFormat: format,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return updatedStory, mentions, nil
+}
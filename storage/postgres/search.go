@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"content/pkg/markdown"
+	"content/pkg/tags"
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+const (
+	SearchSortRelevance = "relevance"
+	SearchSortRecent    = "recent"
+)
+
+func (c *StoryRepo) SearchStories(ctx context.Context, req *pb.SearchStoriesReq) (*pb.SearchStoriesRes, error) {
+	terms := strings.TrimSpace(req.Query)
+	queryParams := []interface{}{terms, req.UserId}
+	conditions := []string{
+		"s.deleted_at = 0",
+		"s.status = 'published'",
+		"s.is_hidden = false",
+		storyListedTo("s", "$2"),
+		"($1 = '' OR s.search_vector @@ q.query)",
+	}
+
+	n := 3
+	if searchTags := tags.NormalizeAll(req.Tags); len(searchTags) > 0 {
+		conditions = append(conditions, fmt.Sprintf(`s.id IN (
+            SELECT story_id FROM story_tags
+            WHERE tag = ANY($%d)
+            GROUP BY story_id
+            HAVING COUNT(DISTINCT tag) = $%d
+        )`, n, n+1))
+		queryParams = append(queryParams, pq.Array(searchTags), len(searchTags))
+		n += 2
+	}
+	if req.Location != "" {
+		conditions = append(conditions, fmt.Sprintf("s.location ILIKE '%%' || $%d || '%%'", n))
+		queryParams = append(queryParams, req.Location)
+		n++
+	}
+	if req.AuthorId != "" {
+		conditions = append(conditions, fmt.Sprintf("s.author_id = $%d", n))
+		queryParams = append(queryParams, req.AuthorId)
+		n++
+	}
+	if req.FromDate != "" {
+		conditions = append(conditions, fmt.Sprintf("s.created_at >= $%d::date", n))
+		queryParams = append(queryParams, req.FromDate)
+		n++
+	}
+	if req.ToDate != "" {
+		conditions = append(conditions, fmt.Sprintf("s.created_at < $%d::date + 1", n))
+		queryParams = append(queryParams, req.ToDate)
+		n++
+	}
+
+	from := `
+        FROM stories s
+        CROSS JOIN websearch_to_tsquery('english', $1) AS q(query)
+        LEFT JOIN users u ON s.author_id = u.id
+        WHERE ` + strings.Join(conditions, " AND ")
+
+	var total int64
+	err := c.DB.QueryRowContext(ctx, "SELECT COUNT(*)"+from, queryParams...).Scan(&total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count search results: %v", err)
+	}
+
+	order := "rank DESC, s.published_at DESC"
+	if req.Sort == SearchSortRecent || req.Query == "" {
+		order = "s.published_at DESC"
+	}
+
+	query := `
+        SELECT s.id, s.title, COALESCE(s.location, ''), s.likes_count, s.comments_count, s.views_count, s.created_at,
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, ''),
+               s.visibility, s.word_count, s.reading_minutes, s.excerpt, ts_rank_cd(s.search_vector, q.query) AS rank,
+               COALESCE(s.content_html, ''), s.content, s.content_format` + from + fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", order, n, n+1)
+	queryParams = append(queryParams, req.Limit, req.Offset)
+
+	rows, err := c.DB.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search stories: %v", err)
+	}
+	defer rows.Close()
+
+	var results []*pb.SearchResult
+	var stories []*pb.Stories
+	var texts []string
+	for rows.Next() {
+		var result pb.SearchResult
+		var story pb.Stories
+		var author pb.Author
+		var contentHTML, content, format string
+
+		err := rows.Scan(
+			&story.StoryId,
+			&story.Title,
+			&story.Location,
+			&story.LikesCount,
+			&story.CommentsCount,
+			&story.ViewsCount,
+			&result.CreatedAt,
+			&author.UserId,
+			&author.Username,
+			&author.FullName,
+			&story.Visibility,
+			&story.WordCount,
+			&story.ReadingMinutes,
+			&story.Excerpt,
+			&result.Rank,
+			&contentHTML,
+			&content,
+			&format,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search result: %v", err)
+		}
+
+		// Stories stored before the renderer existed have no HTML until
+		// the backfill reaches them.
+		if contentHTML == "" {
+			contentHTML = renderContent(format, content)
+		}
+
+		story.Author = &author
+		result.Story = &story
+		result.Snippet = html.EscapeString(story.Excerpt)
+		results = append(results, &result)
+		stories = append(stories, &story)
+		texts = append(texts, markdown.TextFromHTML(contentHTML))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := c.attachCoAuthors(ctx, stories); err != nil {
+		return nil, err
+	}
+
+	if terms != "" && len(results) > 0 {
+		headlines, err := c.searchHeadlines(ctx, terms, texts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to highlight search results: %v", err)
+		}
+		for i, headline := range headlines {
+			results[i].Snippet = headline
+		}
+	}
+
+	return &pb.SearchStoriesRes{
+		Results: results,
+		Total:   total,
+		Offset:  req.Offset,
+		Limit:   req.Limit,
+	}, nil
+}
+
+// Marks the matches in a headline before it is escaped. Neither can occur in
+// the text, which is stripped of them first.
+const (
+	headlineStart = "\x01"
+	headlineStop  = "\x02"
+)
+
+// searchHeadlines highlights the words matching query in the plain text of
+// each story. The headlines are escaped HTML in which only the <mark> tags
+// around matches are markup.
+func (c *StoryRepo) searchHeadlines(ctx context.Context, query string, texts []string) ([]string, error) {
+	cleaned := make([]string, len(texts))
+	for i, text := range texts {
+		cleaned[i] = strings.NewReplacer(headlineStart, "", headlineStop, "").Replace(text)
+	}
+
+	rows, err := c.DB.QueryContext(ctx, `
+        SELECT ts_headline('english', t.text, websearch_to_tsquery('english', $1),
+                           'StartSel=' || chr(1) || ', StopSel=' || chr(2) || ', MaxWords=35, MinWords=15, MaxFragments=2')
+        FROM unnest($2::text[]) WITH ORDINALITY AS t(text, n)
+        ORDER BY t.n
+    `, query, pq.Array(cleaned))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mark := strings.NewReplacer(headlineStart, "<mark>", headlineStop, "</mark>")
+	headlines := make([]string, 0, len(texts))
+	for rows.Next() {
+		var headline string
+		if err := rows.Scan(&headline); err != nil {
+			return nil, err
+		}
+		headlines = append(headlines, mark.Replace(html.EscapeString(headline)))
+	}
+
+	return headlines, rows.Err()
+}
@@ -2,8 +2,13 @@ package postgres
 
 import (
 	pb "content/genproto/story"
+	"content/pkg/markdown"
+	"content/pkg/tags"
 	"context"
 	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
 )
 
 type StoryRepo struct {
@@ -14,28 +19,87 @@ func NewStoryRepository(db *sql.DB) *StoryRepo {
 	return &StoryRepo{DB: db}
 }
 
-func (c *StoryRepo) CreateStory(ctx context.Context, request *pb.CreateStoriesRequest) (*pb.CreateStoriesResponse, error) {
+// CreateStory stores a new story. A story held for review is stored hidden
+// and gets no published_at until a moderator lets it through.
+func (c *StoryRepo) CreateStory(ctx context.Context, request *pb.CreateStoriesRequest, hold *Hold) (*pb.CreateStoriesResponse, error) {
+	status := request.Status
+	if status == "" {
+		status = StoryStatusPublished
+	}
+	if err := validateStoryStatus(status, request.PublishAt); err != nil {
+		return nil, err
+	}
+	format := request.ContentFormat
+	if format == "" {
+		format = ContentFormatPlain
+	}
+	if err := validateContentFormat(format); err != nil {
+		return nil, err
+	}
+	if err := validateContentLength(request.Content); err != nil {
+		return nil, err
+	}
+	visibility := request.Visibility
+	if visibility == "" {
+		visibility = VisibilityPublic
+	}
+	if err := validateVisibility(visibility); err != nil {
+		return nil, err
+	}
+	point, err := geoParams(request.Geo != nil, request.Geo.GetLatitude(), request.Geo.GetLongitude(), request.Geo.GetPlaceName())
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := c.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	query := `
-        INSERT INTO stories (title, content, location, author_id)
-        VALUES ($1, $2, $3, $4)
-        RETURNING id, title, content, location, author_id, created_at
+        INSERT INTO stories (title, content, location, author_id, status, publish_at, published_at,
+                             content_format, content_html, content_html_version, latitude, longitude, place_name, visibility,
+                             word_count, reading_minutes, excerpt, reading_stats_version, is_hidden)
+        VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::timestamptz,
+                CASE WHEN $5 = 'published' AND NOT $18 THEN CURRENT_TIMESTAMP END, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+        RETURNING id, title, content, location, author_id, created_at, status, COALESCE(publish_at::text, ''),
+                  content_format, content_html, visibility
     `
 
 	var createdStory pb.CreateStoriesResponse
-	err = tx.QueryRowContext(ctx, query, request.Title, request.Content, request.Location, request.UserId).Scan(
-		&createdStory.Id, &createdStory.Title, &createdStory.Content, &createdStory.Location, &createdStory.AuthorId, &createdStory.CreatedAt)
+	html := renderContent(format, request.Content)
+	stats := storyReadingStats(html)
+	err = tx.QueryRowContext(ctx, query, request.Title, request.Content, request.Location, request.UserId, status, request.PublishAt,
+		format, html, markdown.Version, point[0], point[1], point[2], visibility,
+		stats.words, stats.minutes, stats.excerpt, readingStatsVersion, hold != nil).Scan(
+		&createdStory.Id, &createdStory.Title, &createdStory.Content, &createdStory.Location, &createdStory.AuthorId, &createdStory.CreatedAt,
+		&createdStory.Status, &createdStory.PublishAt, &createdStory.ContentFormat, &createdStory.ContentHtml, &createdStory.Visibility)
 	if err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
+	_, err = saveMentions(ctx, tx, Mention{
+		AuthorId:   request.UserId,
+		SourceType: MentionSourceStory,
+		SourceId:   createdStory.Id,
+		TargetType: MentionTargetStory,
+		TargetId:   createdStory.Id,
+	}, request.Content)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := fileHold(ctx, tx, hold, ReportTypeStory, createdStory.Id, request.UserId); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	storyTags := tags.NormalizeAll(request.Tags)
+
 	tagQuery := `INSERT INTO story_tags (story_id, tag) VALUES ($1, $2)`
-	for _, tag := range request.Tags {
+	for _, tag := range storyTags {
 		_, err := tx.ExecContext(ctx, tagQuery, createdStory.Id, tag)
 		if err != nil {
 			tx.Rollback()
@@ -47,60 +111,151 @@ func (c *StoryRepo) CreateStory(ctx context.Context, request *pb.CreateStoriesRe
 		return nil, err
 	}
 
-	createdStory.Tags = request.Tags
+	createdStory.Tags = storyTags
+	createdStory.Geo = request.Geo
 
 	return &createdStory, nil
 }
 
-func (c *StoryRepo) UpdateStory(ctx context.Context, request *pb.UpdateStoriesReq) (*pb.UpdateStoriesRes, error) {
+func (c *StoryRepo) UpdateStory(ctx context.Context, request *pb.UpdateStoriesReq) (*pb.UpdateStoriesRes, []Mention, error) {
 	tx, err := c.DB.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	updatedStory, mentions, err := c.updateStory(ctx, tx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return updatedStory, mentions, nil
+}
+
+// updateStory keeps the current title and content as a story revision before
+// overwriting them, so every edit bumps stories.revision. Stories can be
+// edited by their owners and editors, but only owners change its
+// visibility. An empty ContentFormat keeps the story's current format. The returned mentions are
+// the users newly mentioned in a published story; mentions in a story that
+// is not published yet are announced when it is.
+func (c *StoryRepo) updateStory(ctx context.Context, tx *sql.Tx, request *pb.UpdateStoriesReq) (*pb.UpdateStoriesRes, []Mention, error) {
+	role, err := storyRole(ctx, tx, request.Id, request.UserId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if role == "" {
+		return nil, nil, fmt.Errorf("user %s may not edit story %s", request.UserId, request.Id)
+	}
+
+	format := request.ContentFormat
+	if format == "" {
+		err := tx.QueryRowContext(ctx, `
+            SELECT content_format FROM stories WHERE id = $1 AND deleted_at = 0 FOR UPDATE
+        `, request.Id).Scan(&format)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := validateContentFormat(format); err != nil {
+		return nil, nil, err
+	}
+	if err := validateContentLength(request.Content); err != nil {
+		return nil, nil, err
+	}
+	if err := validateVisibility(request.Visibility); err != nil {
+		return nil, nil, err
+	}
+	if request.Visibility != "" && role != CollaboratorOwner {
+		var visibility string
+		err := tx.QueryRowContext(ctx, `
+            SELECT visibility FROM stories WHERE id = $1 AND deleted_at = 0 FOR UPDATE
+        `, request.Id).Scan(&visibility)
+		if err != nil {
+			return nil, nil, err
+		}
+		if visibility != request.Visibility {
+			return nil, nil, fmt.Errorf("only owners may change the visibility of story %s", request.Id)
+		}
+	}
+	point, err := geoParams(request.Geo != nil, request.Geo.GetLatitude(), request.Geo.GetLongitude(), request.Geo.GetPlaceName())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	revisionQuery := `
+        INSERT INTO story_revisions (story_id, revision, title, content, content_format, editor_id, created_at)
+        SELECT id, revision, title, content, content_format, COALESCE(updated_by, author_id), updated_at
+        FROM stories
+        WHERE id = $1 AND deleted_at = 0
+    `
+	if _, err := tx.ExecContext(ctx, revisionQuery, request.Id); err != nil {
+		return nil, nil, err
 	}
 
 	query := `
         UPDATE stories
-        SET title = $1, content = $2, updated_at = CURRENT_TIMESTAMP
+        SET title = $1, content = $2, revision = revision + 1, updated_by = NULLIF($4, '')::uuid,
+            content_format = $5, content_html = $6, content_html_version = $7,
+            latitude = CASE WHEN $8 THEN $9 ELSE latitude END,
+            longitude = CASE WHEN $8 THEN $10 ELSE longitude END,
+            place_name = CASE WHEN $8 THEN $11 ELSE place_name END,
+            visibility = COALESCE(NULLIF($12, ''), visibility),
+            word_count = $13, reading_minutes = $14, excerpt = $15, reading_stats_version = $16,
+            updated_at = CURRENT_TIMESTAMP
         WHERE id = $3 and deleted_at=0
-        RETURNING id, title, content, location, author_id, updated_at
+        RETURNING id, title, content, location, author_id, updated_at, revision, content_format, content_html,
+                  visibility, latitude, longitude, place_name, status
     `
 
 	var updatedStory pb.UpdateStoriesRes
-	err = tx.QueryRowContext(ctx, query, request.Title, request.Content, request.Id).Scan(
-		&updatedStory.Id, &updatedStory.Title, &updatedStory.Content, &updatedStory.Location, &updatedStory.AuthorId, &updatedStory.UpdatedAt)
+	var stored geoColumns
+	var status string
+	html := renderContent(format, request.Content)
+	stats := storyReadingStats(html)
+	err = tx.QueryRowContext(ctx, query, request.Title, request.Content, request.Id, request.UserId,
+		format, html, markdown.Version, request.Geo != nil, point[0], point[1], point[2], request.Visibility,
+		stats.words, stats.minutes, stats.excerpt, readingStatsVersion).Scan(
+		append([]interface{}{&updatedStory.Id, &updatedStory.Title, &updatedStory.Content, &updatedStory.Location, &updatedStory.AuthorId,
+			&updatedStory.UpdatedAt, &updatedStory.Revision, &updatedStory.ContentFormat, &updatedStory.ContentHtml,
+			&updatedStory.Visibility}, append(stored.dest(), &status)...)...)
 	if err != nil {
-		tx.Rollback()
-		return nil, err
+		return nil, nil, err
 	}
+	updatedStory.Geo = storyGeoPoint(stored)
 
-	tagQuery := `SELECT tag FROM story_tags WHERE story_id = $1`
-	rows, err := tx.QueryContext(ctx, tagQuery, updatedStory.Id)
+	tags, err := queryIDs(ctx, tx, `SELECT tag FROM story_tags WHERE story_id = $1`, updatedStory.Id)
 	if err != nil {
-		tx.Rollback()
-		return nil, err
+		return nil, nil, err
 	}
-	defer rows.Close()
+	updatedStory.Tags = tags
 
-	var tags []string
-	for rows.Next() {
-		var tag string
-		if err := rows.Scan(&tag); err != nil {
-			tx.Rollback()
-			return nil, err
-		}
-		tags = append(tags, tag)
+	mentions, err := saveMentions(ctx, tx, Mention{
+		AuthorId:   request.UserId,
+		SourceType: MentionSourceStory,
+		SourceId:   updatedStory.Id,
+		TargetType: MentionTargetStory,
+		TargetId:   updatedStory.Id,
+	}, updatedStory.Content)
+	if err != nil {
+		return nil, nil, err
 	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, err
+	if status != StoryStatusPublished {
+		mentions = nil
 	}
 
-	updatedStory.Tags = tags
-
-	return &updatedStory, nil
+	return &updatedStory, mentions, nil
 }
 
 func (c *StoryRepo) DeleteStory(ctx context.Context, id *pb.StoryId) error {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
 	query := `
         UPDATE stories
@@ -108,24 +263,33 @@ func (c *StoryRepo) DeleteStory(ctx context.Context, id *pb.StoryId) error {
         WHERE id = $1 and deleted_at = 0
     `
 
-	_, err := c.DB.ExecContext(ctx, query, id.Id)
+	_, err = tx.ExecContext(ctx, query, id.Id)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if err := deleteBookmarks(ctx, tx, BookmarkTypeStory, id.Id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (c *StoryRepo) GetAllStory(ctx context.Context, request *pb.GetAllStoriesReq) (*pb.GetAllStoriesRes, error) {
 	query := `
-        SELECT s.id, s.title, s.location, s.likes_count, s.comments_count, u.id, u.username, u.full_name
+        SELECT s.id, s.title, s.location, s.likes_count, s.comments_count, COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, ''),
+               EXISTS (SELECT 1 FROM likes l WHERE l.story_id = s.id AND l.user_id = NULLIF($3, '')::uuid), s.status,
+               s.latitude, s.longitude, s.place_name, s.views_count,
+               EXISTS (SELECT 1 FROM bookmarks b WHERE b.entity_type = 'story' AND b.entity_id = s.id AND b.user_id = NULLIF($3, '')::uuid),
+               s.visibility, s.word_count, s.reading_minutes, s.excerpt
         FROM stories s
-        JOIN users u ON s.author_id = u.id
-        WHERE s.deleted_at = 0
+        LEFT JOIN users u ON s.author_id = u.id
+        WHERE s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false AND ` + storyListedTo("s", "$3") + `
+        ORDER BY s.published_at DESC
         LIMIT $1 OFFSET $2
     `
 
-	rows, err := c.DB.QueryContext(ctx, query, request.Limit, request.Offset)
+	rows, err := c.DB.QueryContext(ctx, query, request.Limit, request.Offset, request.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +299,7 @@ func (c *StoryRepo) GetAllStory(ctx context.Context, request *pb.GetAllStoriesRe
 	for rows.Next() {
 		var story pb.Stories
 		var author pb.Author
+		var point geoColumns
 
 		err := rows.Scan(
 			&story.StoryId,
@@ -145,12 +310,24 @@ func (c *StoryRepo) GetAllStory(ctx context.Context, request *pb.GetAllStoriesRe
 			&author.UserId,
 			&author.Username,
 			&author.FullName,
+			&story.Liked,
+			&story.Status,
+			&point.Latitude,
+			&point.Longitude,
+			&point.PlaceName,
+			&story.ViewsCount,
+			&story.IsBookmarked,
+			&story.Visibility,
+			&story.WordCount,
+			&story.ReadingMinutes,
+			&story.Excerpt,
 		)
 		if err != nil {
 			return nil, err
 		}
 
 		story.Author = &author
+		story.Geo = storyGeoPoint(point)
 		stories = append(stories, &story)
 	}
 
@@ -158,9 +335,15 @@ func (c *StoryRepo) GetAllStory(ctx context.Context, request *pb.GetAllStoriesRe
 		return nil, err
 	}
 
-	countQuery := `SELECT COUNT(*) FROM stories WHERE deleted_at = 0`
+	if err := c.attachCoAuthors(ctx, stories); err != nil {
+		return nil, err
+	}
+
+	countQuery := `
+        SELECT COUNT(*) FROM stories s
+        WHERE s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false AND ` + storyListedTo("s", "$1")
 	var total int64
-	err = c.DB.QueryRowContext(ctx, countQuery).Scan(&total)
+	err = c.DB.QueryRowContext(ctx, countQuery, request.UserId).Scan(&total)
 	if err != nil {
 		return nil, err
 	}
@@ -179,16 +362,26 @@ func (c *StoryRepo) GetStoryById(ctx context.Context, id *pb.StoryId) (*pb.GetSt
 
 	storyQuery := `
         SELECT s.id, s.title, s.content, s.location, s.likes_count, s.comments_count, s.created_at, s.updated_at,
-               u.id, u.username, u.full_name
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, ''),
+               EXISTS (SELECT 1 FROM likes l WHERE l.story_id = s.id AND l.user_id = NULLIF($2, '')::uuid),
+               s.status, COALESCE(s.publish_at::text, ''), COALESCE(s.published_at::text, ''),
+               s.content_format, COALESCE(s.content_html, ''), s.content_html_version,
+               s.latitude, s.longitude, s.place_name, s.views_count,
+               EXISTS (SELECT 1 FROM bookmarks b WHERE b.entity_type = 'story' AND b.entity_id = s.id AND b.user_id = NULLIF($2, '')::uuid),
+               s.visibility
         FROM stories s
-        JOIN users u ON s.author_id = u.id
+        LEFT JOIN users u ON s.author_id = u.id
         WHERE s.id = $1 AND s.deleted_at = 0
+          AND ((s.status = 'published' AND s.is_hidden = false AND ` + visibleTo("s", "$2") + `)
+               OR ` + storyAuthoredBy("s", "$2") + `)
     `
 
 	var story pb.GetStoryRes
 	var author pb.Author
+	var htmlVersion int
+	var point geoColumns
 
-	err := c.DB.QueryRowContext(ctx, storyQuery, id.Id).Scan(
+	err := c.DB.QueryRowContext(ctx, storyQuery, id.Id, id.UserId).Scan(
 		&story.Id,
 		&story.Title,
 		&story.Content,
@@ -200,12 +393,27 @@ func (c *StoryRepo) GetStoryById(ctx context.Context, id *pb.StoryId) (*pb.GetSt
 		&author.UserId,
 		&author.Username,
 		&author.FullName,
+		&story.Liked,
+		&story.Status,
+		&story.PublishAt,
+		&story.PublishedAt,
+		&story.ContentFormat,
+		&story.ContentHtml,
+		&htmlVersion,
+		&point.Latitude,
+		&point.Longitude,
+		&point.PlaceName,
+		&story.ViewsCount,
+		&story.IsBookmarked,
+		&story.Visibility,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	story.Author = &author
+	story.Geo = storyGeoPoint(point)
+	c.refreshContentHTML(ctx, &story, htmlVersion)
 
 	tagQuery := `SELECT tag FROM story_tags WHERE story_id = $1`
 	rows, err := c.DB.QueryContext(ctx, tagQuery, story.Id)
@@ -225,42 +433,123 @@ func (c *StoryRepo) GetStoryById(ctx context.Context, id *pb.StoryId) (*pb.GetSt
 
 	story.Tags = tags
 
+	media, err := c.storyMedia(ctx, story.Id)
+	if err != nil {
+		return nil, err
+	}
+	story.Media = media
+
+	coAuthors, err := c.storyCoAuthors(ctx, []string{story.Id})
+	if err != nil {
+		return nil, err
+	}
+	story.CoAuthors = coAuthors[story.Id]
+
 	return &story, nil
 }
 
-func (c *StoryRepo) CommentToStory(ctx context.Context, req *pb.CommentStoryReq) (*pb.CommentStoryRes, error) {
+// CommentToStory adds a comment or, with ParentCommentId, a reply. Threads are
+// one level deep: replying to a reply attaches to its top-level comment.
+// stories.comments_count counts every comment including replies.
+func (c *StoryRepo) CommentToStory(ctx context.Context, req *pb.CommentStoryReq, hold *Hold) (*pb.CommentStoryRes, []Mention, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	if err := storyViewable(ctx, tx, req.StoryId, req.AuthorId); err != nil {
+		return nil, nil, err
+	}
+
+	var parentID sql.NullString
+	if req.ParentCommentId != "" {
+		var parentStoryID string
+		parentQuery := `
+        SELECT COALESCE(parent_comment_id, id), story_id
+        FROM comments
+        WHERE id = $1 AND deleted_at = 0
+    `
+		err := tx.QueryRowContext(ctx, parentQuery, req.ParentCommentId).Scan(&parentID, &parentStoryID)
+		if err == sql.ErrNoRows {
+			return nil, nil, fmt.Errorf("comment %s not found", req.ParentCommentId)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if parentStoryID != req.StoryId {
+			return nil, nil, fmt.Errorf("comment %s does not belong to story %s", req.ParentCommentId, req.StoryId)
+		}
+	}
 
 	query := `
-        INSERT INTO comments (id, content, author_id, story_id, created_at)
-        VALUES (gen_random_uuid(), $1, $2, $3, CURRENT_TIMESTAMP)
-        RETURNING id, content, author_id, story_id, created_at
+        INSERT INTO comments (id, content, author_id, story_id, parent_comment_id, created_at, is_hidden)
+        VALUES (gen_random_uuid(), $1, $2, $3, $4, CURRENT_TIMESTAMP, $5)
+        RETURNING id, content, author_id, story_id, COALESCE(parent_comment_id::text, ''), created_at
     `
 
 	var comment pb.CommentStoryRes
 
-	err := c.DB.QueryRowContext(ctx, query, req.Content, req.AuthorId, req.StoryId).Scan(
+	err = tx.QueryRowContext(ctx, query, req.Content, req.AuthorId, req.StoryId, parentID, hold != nil).Scan(
 		&comment.Id,
 		&comment.Content,
 		&comment.AuthorId,
 		&comment.StoryId,
+		&comment.ParentCommentId,
 		&comment.CreatedAt,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	if err := fileHold(ctx, tx, hold, ReportTypeStoryComment, comment.Id, req.AuthorId); err != nil {
+		return nil, nil, err
+	}
+
+	if parentID.Valid {
+		repliesQuery := `
+		UPDATE comments SET replies_count = replies_count + 1 WHERE id = $1
+		`
+		if _, err := tx.ExecContext(ctx, repliesQuery, parentID); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	updatequery := `
 	UPDATE stories SET comments_count = comments_count + 1 WHERE id = $1
 	`
-	_, err = c.DB.ExecContext(ctx, updatequery, req.StoryId)
+	_, err = tx.ExecContext(ctx, updatequery, req.StoryId)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	mentions, err := saveMentions(ctx, tx, Mention{
+		AuthorId:   req.AuthorId,
+		SourceType: MentionSourceStoryComment,
+		SourceId:   comment.Id,
+		TargetType: MentionTargetStory,
+		TargetId:   req.StoryId,
+	}, req.Content)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return &comment, nil
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return &comment, mentions, nil
 }
 
+// GetCommentsOfStory lists top-level comments, newest first, each with up to
+// req.RepliesLimit of its oldest replies inlined. Total counts top-level
+// comments only. Deleted comments that still have replies are kept in the
+// thread as "[deleted]".
 func (c *StoryRepo) GetCommentsOfStory(ctx context.Context, req *pb.GetCommentsOfStoryReq) (*pb.GetCommentsOfStoryRes, error) {
 
+	if err := storyViewable(ctx, c.DB, req.StoryId, req.UserId); err != nil {
+		return nil, err
+	}
+
 	res := &pb.GetCommentsOfStoryRes{
 		Offset: req.Offset,
 		Limit:  req.Limit,
@@ -269,7 +558,7 @@ func (c *StoryRepo) GetCommentsOfStory(ctx context.Context, req *pb.GetCommentsO
 	totalQuery := `
         SELECT COUNT(*)
         FROM comments
-        WHERE story_id = $1
+        WHERE story_id = $1 AND parent_comment_id IS NULL AND (deleted_at = 0 OR replies_count > 0)
     `
 	var totalComments int64
 	err := c.DB.QueryRowContext(ctx, totalQuery, req.StoryId).Scan(&totalComments)
@@ -279,14 +568,200 @@ func (c *StoryRepo) GetCommentsOfStory(ctx context.Context, req *pb.GetCommentsO
 	res.Total = totalComments
 
 	commentsQuery := `
-        SELECT c.id, c.content, c.created_at, u.id, u.username, u.full_name
+        SELECT ` + commentColumns + `
         FROM comments c
-        JOIN users u ON c.author_id = u.id
-        WHERE c.story_id = $1
+        LEFT JOIN users u ON c.author_id = u.id AND c.deleted_at = 0
+        WHERE c.story_id = $1 AND c.parent_comment_id IS NULL AND (c.deleted_at = 0 OR c.replies_count > 0)
         ORDER BY c.created_at DESC
         OFFSET $2 LIMIT $3
     `
-	rows, err := c.DB.QueryContext(ctx, commentsQuery, req.StoryId, req.Offset, req.Limit)
+	comments, err := c.queryComments(ctx, commentsQuery, req.StoryId, req.Offset, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.RepliesLimit > 0 && len(comments) > 0 {
+		ids := make([]string, len(comments))
+		byID := make(map[string]*pb.Comments, len(comments))
+		for i, comment := range comments {
+			ids[i] = comment.Id
+			byID[comment.Id] = comment
+		}
+
+		repliesQuery := `
+        SELECT ` + commentColumns + `
+        FROM (
+            SELECT *, ROW_NUMBER() OVER (PARTITION BY parent_comment_id ORDER BY created_at) AS rn
+            FROM comments
+            WHERE parent_comment_id = ANY($1) AND deleted_at = 0
+        ) c
+        LEFT JOIN users u ON c.author_id = u.id AND c.deleted_at = 0
+        WHERE c.rn <= $2
+        ORDER BY c.created_at
+    `
+		replies, err := c.queryComments(ctx, repliesQuery, pq.Array(ids), req.RepliesLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, reply := range replies {
+			parent := byID[reply.ParentCommentId]
+			parent.Replies = append(parent.Replies, reply)
+		}
+	}
+
+	res.Comments = comments
+
+	return res, nil
+}
+
+func (c *StoryRepo) GetCommentReplies(ctx context.Context, req *pb.GetCommentRepliesReq) (*pb.GetCommentRepliesRes, error) {
+	var storyID string
+	err := c.DB.QueryRowContext(ctx, `SELECT story_id FROM comments WHERE id = $1`, req.CommentId).Scan(&storyID)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("comment %s not found", req.CommentId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := storyViewable(ctx, c.DB, storyID, req.UserId); err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetCommentRepliesRes{
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	}
+
+	totalQuery := `SELECT COUNT(*) FROM comments WHERE parent_comment_id = $1 AND deleted_at = 0`
+	if err := c.DB.QueryRowContext(ctx, totalQuery, req.CommentId).Scan(&res.Total); err != nil {
+		return nil, err
+	}
+
+	repliesQuery := `
+        SELECT ` + commentColumns + `
+        FROM comments c
+        LEFT JOIN users u ON c.author_id = u.id AND c.deleted_at = 0
+        WHERE c.parent_comment_id = $1 AND c.deleted_at = 0
+        ORDER BY c.created_at
+        OFFSET $2 LIMIT $3
+    `
+	replies, err := c.queryComments(ctx, repliesQuery, req.CommentId, req.Offset, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	res.Comments = replies
+
+	return res, nil
+}
+
+func (c *StoryRepo) EditComment(ctx context.Context, req *pb.EditCommentReq) (*pb.Comments, error) {
+	query := `
+        UPDATE comments
+        SET content = $1, edited_at = CURRENT_TIMESTAMP
+        WHERE id = $2 AND author_id = $3 AND deleted_at = 0
+    `
+	updated, err := c.DB.ExecContext(ctx, query, req.Content, req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	n, err := updated.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("comment %s not found or not written by user %s", req.Id, req.UserId)
+	}
+
+	commentQuery := `
+        SELECT ` + commentColumns + `
+        FROM comments c
+        LEFT JOIN users u ON c.author_id = u.id AND c.deleted_at = 0
+        WHERE c.id = $1
+    `
+	comments, err := c.queryComments(ctx, commentQuery, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(comments) == 0 {
+		return nil, fmt.Errorf("comment %s not found", req.Id)
+	}
+
+	return comments[0], nil
+}
+
+// DeleteComment soft-deletes a comment on behalf of its author or a moderator
+// and takes it out of comments_count and its parent's replies_count.
+func (c *StoryRepo) DeleteComment(ctx context.Context, req *pb.DeleteCommentReq) error {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var storyID, parentID string
+	var isAuthor bool
+	var deletedAt int64
+	commentQuery := `
+        SELECT COALESCE(author_id = NULLIF($2, '')::uuid, false), story_id, COALESCE(parent_comment_id::text, ''), deleted_at
+        FROM comments
+        WHERE id = $1
+        FOR UPDATE
+    `
+	err = tx.QueryRowContext(ctx, commentQuery, req.Id, req.UserId).Scan(&isAuthor, &storyID, &parentID, &deletedAt)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("comment %s not found", req.Id)
+	}
+	if err != nil {
+		return err
+	}
+	if deletedAt != 0 {
+		return nil
+	}
+
+	if !isAuthor {
+		moderator, err := isModerator(ctx, tx, req.UserId)
+		if err != nil {
+			return err
+		}
+		if !moderator {
+			return fmt.Errorf("user %s may not delete comment %s", req.UserId, req.Id)
+		}
+	}
+
+	deleteQuery := `
+        UPDATE comments
+        SET deleted_at = date_part('epoch', current_timestamp)::INT
+        WHERE id = $1
+    `
+	if _, err := tx.ExecContext(ctx, deleteQuery, req.Id); err != nil {
+		return err
+	}
+
+	if parentID != "" {
+		repliesQuery := `
+        UPDATE comments SET replies_count = GREATEST(replies_count - 1, 0) WHERE id = $1
+    `
+		if _, err := tx.ExecContext(ctx, repliesQuery, parentID); err != nil {
+			return err
+		}
+	}
+
+	updatequery := `
+	UPDATE stories SET comments_count = GREATEST(comments_count - 1, 0) WHERE id = $1
+	`
+	if _, err := tx.ExecContext(ctx, updatequery, storyID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+const commentColumns = `c.id, CASE WHEN c.deleted_at <> 0 THEN '[deleted]' WHEN c.is_hidden THEN '[hidden]' ELSE c.content END, c.created_at,
+               COALESCE(c.parent_comment_id::text, ''), c.replies_count, COALESCE(c.edited_at::text, ''), c.deleted_at <> 0,
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, '')`
+
+func (c *StoryRepo) queryComments(ctx context.Context, query string, args ...interface{}) ([]*pb.Comments, error) {
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +771,8 @@ func (c *StoryRepo) GetCommentsOfStory(ctx context.Context, req *pb.GetCommentsO
 	for rows.Next() {
 		var comment pb.Comments
 		var author pb.Author
-		err := rows.Scan(&comment.Id, &comment.Content, &comment.CreatedAt, &author.UserId, &author.Username, &author.FullName)
+		err := rows.Scan(&comment.Id, &comment.Content, &comment.CreatedAt, &comment.ParentCommentId, &comment.RepliesCount,
+			&comment.EditedAt, &comment.Deleted, &author.UserId, &author.Username, &author.FullName)
 		if err != nil {
 			return nil, err
 		}
@@ -304,12 +780,34 @@ func (c *StoryRepo) GetCommentsOfStory(ctx context.Context, req *pb.GetCommentsO
 		comments = append(comments, &comment)
 	}
 
-	res.Comments = comments
-
-	return res, nil
+	return comments, rows.Err()
 }
 
+// Like is idempotent: liking a story twice returns the existing like and
+// leaves likes_count untouched.
 func (c *StoryRepo) Like(ctx context.Context, req *pb.LikeReq) (*pb.LikeRes, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res := &pb.LikeRes{
+		UserId:  req.UserId,
+		StoryId: req.StoryId,
+	}
+
+	err = tx.QueryRowContext(ctx, `
+        SELECT likes_count FROM stories s
+        WHERE s.id = $1 AND s.deleted_at = 0 AND s.status = 'published' AND `+storyVisibleTo("s", "$2")+`
+        FOR UPDATE
+    `, req.StoryId, req.UserId).Scan(&res.LikesCount)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("story %s not found", req.StoryId)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	query := `
         INSERT INTO likes (user_id, story_id, created_at)
@@ -317,26 +815,142 @@ func (c *StoryRepo) Like(ctx context.Context, req *pb.LikeReq) (*pb.LikeRes, err
         ON CONFLICT (user_id, story_id) DO NOTHING
         RETURNING created_at
     `
+	err = tx.QueryRowContext(ctx, query, req.UserId, req.StoryId).Scan(&res.LikedAt)
+	if err == sql.ErrNoRows {
+		existingQuery := `SELECT created_at FROM likes WHERE user_id = $1 AND story_id = $2`
+		if err := tx.QueryRowContext(ctx, existingQuery, req.UserId, req.StoryId).Scan(&res.LikedAt); err != nil {
+			return nil, err
+		}
+		return res, tx.Commit()
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	var likedAt string
-	err := c.DB.QueryRowContext(ctx, query, req.UserId, req.StoryId).Scan(&likedAt)
+	updatequery := `
+	UPDATE stories SET likes_count = likes_count + 1 WHERE id = $1 RETURNING likes_count
+	`
+	if err := tx.QueryRowContext(ctx, updatequery, req.StoryId).Scan(&res.LikesCount); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *StoryRepo) Unlike(ctx context.Context, req *pb.LikeReq) (*pb.UnlikeRes, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback()
 
-	res := &pb.LikeRes{
+	res := &pb.UnlikeRes{
 		UserId:  req.UserId,
 		StoryId: req.StoryId,
-		LikedAt: likedAt,
 	}
 
-	updatequery := `
-	UPDATE stories SET likes_count = likes_count + 1 WHERE id = $1
-	`
-	_, err = c.DB.ExecContext(ctx, updatequery, req.StoryId)
+	err = tx.QueryRowContext(ctx, `
+        SELECT likes_count FROM stories WHERE id = $1 FOR UPDATE
+    `, req.StoryId).Scan(&res.LikesCount)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("story %s not found", req.StoryId)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	deleted, err := execCount(ctx, tx, `DELETE FROM likes WHERE user_id = $1 AND story_id = $2`, req.UserId, req.StoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted > 0 {
+		updatequery := `
+		UPDATE stories SET likes_count = GREATEST(likes_count - 1, 0) WHERE id = $1 RETURNING likes_count
+		`
+		if err := tx.QueryRowContext(ctx, updatequery, req.StoryId).Scan(&res.LikesCount); err != nil {
+			return nil, err
+		}
+		res.Unliked = true
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	return res, nil
 }
+
+// GetStoryLikers lists who liked a story, newest first. Followed and mutual
+// are relative to req.UserId; with MutualFirst, likers the caller follows and
+// who follow the caller back are listed before everyone else.
+func (c *StoryRepo) GetStoryLikers(ctx context.Context, req *pb.GetStoryLikersReq) (*pb.GetStoryLikersRes, error) {
+	if err := storyViewable(ctx, c.DB, req.StoryId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetStoryLikersRes{
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	}
+
+	totalQuery := `
+        SELECT COUNT(*)
+        FROM likes l
+        JOIN users u ON l.user_id = u.id
+        WHERE l.story_id = $1 AND u.deleted_at = 0
+    `
+	if err := c.DB.QueryRowContext(ctx, totalQuery, req.StoryId).Scan(&res.Total); err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT u.id, u.username, u.full_name, l.created_at,
+               fo.follower_id IS NOT NULL AS followed,
+               fo.follower_id IS NOT NULL AND fb.follower_id IS NOT NULL AS mutual
+        FROM likes l
+        JOIN users u ON l.user_id = u.id
+        LEFT JOIN followers fo ON fo.follower_id = NULLIF($2, '')::uuid AND fo.following_id = l.user_id
+        LEFT JOIN followers fb ON fb.follower_id = l.user_id AND fb.following_id = NULLIF($2, '')::uuid
+        WHERE l.story_id = $1 AND u.deleted_at = 0
+        ORDER BY ($3 AND fo.follower_id IS NOT NULL AND fb.follower_id IS NOT NULL) DESC, l.created_at DESC
+        LIMIT $4 OFFSET $5
+    `
+	rows, err := c.DB.QueryContext(ctx, query, req.StoryId, req.UserId, req.MutualFirst, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var liker pb.Liker
+		var author pb.Author
+		err := rows.Scan(&author.UserId, &author.Username, &author.FullName, &liker.LikedAt, &liker.Followed, &liker.Mutual)
+		if err != nil {
+			return nil, err
+		}
+		liker.Author = &author
+		res.Likers = append(res.Likers, &liker)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func storyGeoPoint(g geoColumns) *pb.GeoPoint {
+	if !g.Latitude.Valid {
+		return nil
+	}
+	return &pb.GeoPoint{
+		Latitude:  g.Latitude.Float64,
+		Longitude: g.Longitude.Float64,
+		PlaceName: g.PlaceName.String,
+	}
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"context"
+	"encoding/json"
+)
+
+// SetStoryMedia replaces the ordered media list of a story. Only the author
+// can change it, and only with media they uploaded themselves.
+func (c *StoryRepo) SetStoryMedia(ctx context.Context, req *pb.SetStoryMediaReq) (*pb.StoryMediaRes, error) {
+	if err := setAttachedMedia(ctx, c.DB, storyMediaList, req.StoryId, req.UserId, req.MediaIds); err != nil {
+		return nil, err
+	}
+
+	media, err := c.storyMedia(ctx, req.StoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StoryMediaRes{
+		StoryId: req.StoryId,
+		Media:   media,
+	}, nil
+}
+
+func (c *StoryRepo) storyMedia(ctx context.Context, storyID string) ([]*pb.Media, error) {
+	return attachedMedia(ctx, c.DB, storyMediaList, storyID, scanStoryMedia)
+}
+
+func scanStoryMedia(row interface{ Scan(...interface{}) error }) (*pb.Media, error) {
+	var m pb.Media
+	var renditions []byte
+	err := row.Scan(&m.Id, &m.OwnerId, &m.Url, &m.ContentType, &m.Size, &m.Width, &m.Height,
+		&m.Checksum, &m.AltText, &m.Status, &m.CreatedAt, &m.DominantColor, &renditions)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(renditions, &m.Renditions); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
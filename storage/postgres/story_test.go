@@ -21,7 +21,7 @@ func TestCreateStory(t *testing.T) {
 		Location: "new",
 		Tags:     []string{"new1", "new2", "new3"},
 		UserId:   "0d39904b-05ce-4a9b-bd72-f6f4d66c1ba1",
-	})
+	}, nil)
 	if err != nil {
 		fmt.Println(err)
 	}
@@ -49,10 +49,11 @@ func TestUpdateStory(t *testing.T) {
 	}
 	defer db.Close()
 	con := NewStoryRepository(db)
-	req, err := con.UpdateStory(context.Background(), &pb.UpdateStoriesReq{
+	req, _, err := con.UpdateStory(context.Background(), &pb.UpdateStoriesReq{
 		Id:      "3f9e0c08-323f-4fdf-868e-7bfbd092dabe",
 		Title:   "old",
 		Content: "old",
+		UserId:  "0d39904b-05ce-4a9b-bd72-f6f4d66c1ba1",
 	})
 	if err != nil {
 		fmt.Println(err)
@@ -143,11 +144,11 @@ func TestCommentToStory(t *testing.T) {
 	}
 	defer db.Close()
 	con := NewStoryRepository(db)
-	res, err := con.CommentToStory(context.Background(), &pb.CommentStoryReq{
+	res, _, err := con.CommentToStory(context.Background(), &pb.CommentStoryReq{
 		StoryId:  "3f9e0c08-323f-4fdf-868e-7bfbd092dabe",
 		Content:  "zor",
 		AuthorId: "0d39904b-05ce-4a9b-bd72-f6f4d66c1ba1",
-	})
+	}, nil)
 	if err != nil {
 		fmt.Println(err)
 	}
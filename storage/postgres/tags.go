@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"content/pkg/tags"
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func (c *StoryRepo) GetStoriesByTag(ctx context.Context, req *pb.GetStoriesByTagReq) (*pb.GetAllStoriesRes, error) {
+	tag := tags.Normalize(req.Tag)
+
+	query := `
+        SELECT s.id, s.title, COALESCE(s.location, ''), s.likes_count, s.comments_count, s.views_count,
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, ''), s.visibility
+        FROM stories s
+        JOIN story_tags t ON t.story_id = s.id
+        LEFT JOIN users u ON s.author_id = u.id
+        WHERE t.tag = $1 AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+          AND ` + storyListedTo("s", "$4") + `
+        ORDER BY s.published_at DESC
+        LIMIT $2 OFFSET $3
+    `
+
+	rows, err := c.DB.QueryContext(ctx, query, tag, req.Limit, req.Offset, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stories []*pb.Stories
+	for rows.Next() {
+		var story pb.Stories
+		var author pb.Author
+
+		err := rows.Scan(
+			&story.StoryId,
+			&story.Title,
+			&story.Location,
+			&story.LikesCount,
+			&story.CommentsCount,
+			&story.ViewsCount,
+			&author.UserId,
+			&author.Username,
+			&author.FullName,
+			&story.Visibility,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		story.Author = &author
+		stories = append(stories, &story)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := c.attachCoAuthors(ctx, stories); err != nil {
+		return nil, err
+	}
+
+	countQuery := `
+        SELECT COUNT(*)
+        FROM stories s
+        JOIN story_tags t ON t.story_id = s.id
+        WHERE t.tag = $1 AND s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false
+          AND ` + storyListedTo("s", "$2") + `
+    `
+	var total int64
+	err = c.DB.QueryRowContext(ctx, countQuery, tag, req.UserId).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetAllStoriesRes{
+		Stories: stories,
+		Total:   total,
+		Offset:  req.Offset,
+		Limit:   req.Limit,
+	}, nil
+}
+
+// GetTrendingTags counts how often each tag was attached to a live public
+// story in the last req.Days days. A zero window counts over all time.
+func (c *StoryRepo) GetTrendingTags(ctx context.Context, req *pb.GetTrendingTagsReq) (*pb.TagsRes, error) {
+	query := `
+        SELECT t.tag, COUNT(*) AS uses
+        FROM story_tags t
+        JOIN stories s ON t.story_id = s.id
+        WHERE s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false AND s.visibility = 'public'
+          AND ($1 = 0 OR t.created_at >= CURRENT_TIMESTAMP - make_interval(days => $1::int))
+        GROUP BY t.tag
+        ORDER BY uses DESC, t.tag
+        LIMIT $2
+    `
+
+	return c.queryTagCounts(ctx, query, req.Days, req.Limit)
+}
+
+func (c *StoryRepo) AutocompleteTags(ctx context.Context, req *pb.AutocompleteTagsReq) (*pb.TagsRes, error) {
+	prefix := tags.Normalize(req.Prefix)
+	if prefix == "" {
+		return &pb.TagsRes{}, nil
+	}
+
+	query := `
+        SELECT t.tag, COUNT(*) AS uses
+        FROM story_tags t
+        JOIN stories s ON t.story_id = s.id
+        WHERE s.deleted_at = 0 AND s.status = 'published' AND s.is_hidden = false AND s.visibility = 'public'
+          AND t.tag LIKE $1 || '%'
+        GROUP BY t.tag
+        ORDER BY uses DESC, t.tag
+        LIMIT $2
+    `
+
+	return c.queryTagCounts(ctx, query, prefix, req.Limit)
+}
+
+func (c *StoryRepo) queryTagCounts(ctx context.Context, query string, args ...interface{}) (*pb.TagsRes, error) {
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res pb.TagsRes
+	for rows.Next() {
+		var tag pb.TagCount
+		if err := rows.Scan(&tag.Tag, &tag.Count); err != nil {
+			return nil, err
+		}
+		res.Tags = append(res.Tags, &tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *StoryRepo) AddStoryTags(ctx context.Context, req *pb.StoryTagsReq) (*pb.StoryTagsRes, error) {
+	return c.changeStoryTags(ctx, req, `
+        INSERT INTO story_tags (story_id, tag) VALUES ($1, $2)
+        ON CONFLICT (story_id, tag) DO NOTHING
+    `)
+}
+
+func (c *StoryRepo) RemoveStoryTags(ctx context.Context, req *pb.StoryTagsReq) (*pb.StoryTagsRes, error) {
+	return c.changeStoryTags(ctx, req, `DELETE FROM story_tags WHERE story_id = $1 AND tag = $2`)
+}
+
+func (c *StoryRepo) changeStoryTags(ctx context.Context, req *pb.StoryTagsReq, query string) (*pb.StoryTagsRes, error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var isAuthor bool
+	err = tx.QueryRowContext(ctx, `
+        SELECT COALESCE(author_id = NULLIF($2, '')::uuid, false)
+        FROM stories WHERE id = $1 AND deleted_at = 0 FOR UPDATE
+    `, req.StoryId, req.UserId).Scan(&isAuthor)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("story %s not found", req.StoryId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthor {
+		return nil, fmt.Errorf("user %s is not the author of story %s", req.UserId, req.StoryId)
+	}
+
+	for _, tag := range tags.NormalizeAll(req.Tags) {
+		if _, err := tx.ExecContext(ctx, query, req.StoryId, tag); err != nil {
+			return nil, err
+		}
+	}
+
+	storyTags, err := queryIDs(ctx, tx, `SELECT tag FROM story_tags WHERE story_id = $1 ORDER BY tag`, req.StoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &pb.StoryTagsRes{
+		StoryId: req.StoryId,
+		Tags:    storyTags,
+	}, nil
+}
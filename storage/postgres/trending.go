@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	pb "content/genproto/story"
+	"content/pkg/tags"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// TrendingStats is the engagement a story's trending score is computed from.
+type TrendingStats struct {
+	StoryId     string
+	Likes       int64
+	Comments    int64
+	Views       int64
+	PublishedAt time.Time
+}
+
+const trendingStatsQuery = `
+        SELECT id, likes_count, comments_count, views_count, published_at
+        FROM stories
+        WHERE deleted_at = 0 AND status = 'published' AND is_hidden = false AND published_at IS NOT NULL
+`
+
+func scanTrendingStats(row interface{ Scan(...interface{}) error }) (*TrendingStats, error) {
+	var stats TrendingStats
+	err := row.Scan(&stats.StoryId, &stats.Likes, &stats.Comments, &stats.Views, &stats.PublishedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// TrendingStats returns the engagement of every live story published since
+// the given time.
+func (c *StoryRepo) TrendingStats(ctx context.Context, since time.Time) ([]TrendingStats, error) {
+	rows, err := c.DB.QueryContext(ctx, trendingStatsQuery+` AND published_at >= $1`, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []TrendingStats
+	for rows.Next() {
+		s, err := scanTrendingStats(rows)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, *s)
+	}
+
+	return stats, rows.Err()
+}
+
+// StoryTrendingStats returns the engagement of one story. It returns nil when
+// the story is not live, so it can be dropped from the rankings.
+func (c *StoryRepo) StoryTrendingStats(ctx context.Context, storyID string) (*TrendingStats, error) {
+	stats, err := scanTrendingStats(c.DB.QueryRowContext(ctx, trendingStatsQuery+` AND id = $1`, storyID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return stats, err
+}
+
+// GetTrendingStories loads the ranked stories, keeping the order of ids and
+// applying the location and tag filters and the caller's access. Stories
+// published before since have dropped out of the window and are skipped even
+// if the ranking still lists them.
+func (c *StoryRepo) GetTrendingStories(ctx context.Context, ids []string, since time.Time, req *pb.GetTrendingStoriesReq) (*pb.GetAllStoriesRes, error) {
+	queryParams := []interface{}{pq.Array(ids), since, req.UserId}
+	conditions := []string{
+		"s.id::text = ANY($1)",
+		"s.published_at >= $2",
+		"s.deleted_at = 0",
+		"s.status = 'published'",
+		"s.is_hidden = false",
+		storyListedTo("s", "$3"),
+	}
+
+	n := 4
+	if req.Location != "" {
+		conditions = append(conditions, fmt.Sprintf("s.location ILIKE '%%' || $%d || '%%'", n))
+		queryParams = append(queryParams, req.Location)
+		n++
+	}
+	if req.Tag != "" {
+		conditions = append(conditions, fmt.Sprintf("s.id IN (SELECT story_id FROM story_tags WHERE tag = $%d)", n))
+		queryParams = append(queryParams, tags.Normalize(req.Tag))
+		n++
+	}
+
+	from := `
+        FROM stories s
+        LEFT JOIN users u ON s.author_id = u.id
+        WHERE ` + strings.Join(conditions, " AND ")
+
+	var total int64
+	err := c.DB.QueryRowContext(ctx, "SELECT COUNT(*)"+from, queryParams...).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+        SELECT s.id, s.title, COALESCE(s.location, ''), s.likes_count, s.comments_count, s.views_count,
+               COALESCE(u.id::text, ''), COALESCE(u.username, ''), COALESCE(u.full_name, ''), s.visibility` + from +
+		fmt.Sprintf(" ORDER BY array_position($1, s.id::text) LIMIT $%d OFFSET $%d", n, n+1)
+	queryParams = append(queryParams, req.Limit, req.Offset)
+
+	rows, err := c.DB.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stories []*pb.Stories
+	for rows.Next() {
+		var story pb.Stories
+		var author pb.Author
+
+		err := rows.Scan(
+			&story.StoryId,
+			&story.Title,
+			&story.Location,
+			&story.LikesCount,
+			&story.CommentsCount,
+			&story.ViewsCount,
+			&author.UserId,
+			&author.Username,
+			&author.FullName,
+			&story.Visibility,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		story.Author = &author
+		stories = append(stories, &story)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := c.attachCoAuthors(ctx, stories); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetAllStoriesRes{
+		Stories: stories,
+		Total:   total,
+		Offset:  req.Offset,
+		Limit:   req.Limit,
+	}, nil
+}
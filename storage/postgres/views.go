@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// StoryViewable reports whether a story exists and can be read by the
+// viewer.
+func (c *StoryRepo) StoryViewable(ctx context.Context, storyID, userID string) error {
+	return storyViewable(ctx, c.DB, storyID, userID)
+}
+
+// AddStoryViews adds the given view counts to stories.views_count. Stories
+// that were deleted meanwhile are skipped.
+func (c *StoryRepo) AddStoryViews(ctx context.Context, counts map[string]int64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(counts))
+	views := make([]int64, 0, len(counts))
+	for id, n := range counts {
+		ids = append(ids, id)
+		views = append(views, n)
+	}
+
+	_, err := c.DB.ExecContext(ctx, `
+        UPDATE stories s
+        SET views_count = s.views_count + v.n
+        FROM unnest($1::text[], $2::bigint[]) AS v(id, n)
+        WHERE s.id::text = v.id
+    `, pq.Array(ids), pq.Array(views))
+	return err
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+const (
+	VisibilityPublic    = "public"
+	VisibilityFollowers = "followers"
+	VisibilityUnlisted  = "unlisted"
+	VisibilityPrivate   = "private"
+)
+
+// validateVisibility accepts the empty string, which means public on create
+// and unchanged on update.
+func validateVisibility(visibility string) error {
+	switch visibility {
+	case "", VisibilityPublic, VisibilityFollowers, VisibilityUnlisted, VisibilityPrivate:
+		return nil
+	}
+	return fmt.Errorf("unknown visibility: %q", visibility)
+}
+
+// visibleTo returns the SQL condition under which the story or itinerary
+// aliased alias can be opened by viewer, an SQL expression holding the
+// caller's user id (empty for anonymous callers). Public and unlisted content
+// is open to everybody, followers-only content to the author's followers
+// and private content to the author alone.
+func visibleTo(alias, viewer string) string {
+	return fmt.Sprintf(`(%s.visibility IN ('public', 'unlisted') OR %s)`, alias, ownedOrFollowed(alias, viewer))
+}
+
+// listedTo is visibleTo for listings and search: unlisted content is only
+// reachable through its id, so it is left out for everybody but its author.
+func listedTo(alias, viewer string) string {
+	return fmt.Sprintf(`(%s.visibility = 'public' OR %s)`, alias, ownedOrFollowed(alias, viewer))
+}
+
+// storyVisibleTo and storyListedTo are visibleTo and listedTo for stories,
+// which their co-authors see like their creator does.
+func storyVisibleTo(alias, viewer string) string {
+	return fmt.Sprintf(`(%s OR %s)`, visibleTo(alias, viewer), storyAuthoredBy(alias, viewer))
+}
+
+func storyListedTo(alias, viewer string) string {
+	return fmt.Sprintf(`(%s OR %s)`, listedTo(alias, viewer), storyAuthoredBy(alias, viewer))
+}
+
+func ownedOrFollowed(alias, viewer string) string {
+	return fmt.Sprintf(`%[1]s.author_id = NULLIF(%[2]s, '')::uuid
+            OR (%[1]s.visibility = 'followers' AND EXISTS (
+                SELECT 1 FROM followers vf
+                WHERE vf.follower_id = NULLIF(%[2]s, '')::uuid AND vf.following_id = %[1]s.author_id))`, alias, viewer)
+}
+
+// storyViewable reports whether a story exists and can be read by the
+// viewer: published stories it is visible to, and any story it co-authors.
+// Stories the viewer may not see are reported as not found.
+func storyViewable(ctx context.Context, q rowQueryer, storyID, userID string) error {
+	var exists bool
+	err := q.QueryRowContext(ctx, `
+        SELECT TRUE FROM stories s
+        WHERE s.id::text = $1 AND s.deleted_at = 0
+          AND ((s.status = 'published' AND s.is_hidden = false AND `+visibleTo("s", "$2")+`)
+               OR `+storyAuthoredBy("s", "$2")+`)
+    `, storyID, userID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("story %s not found", storyID)
+	}
+	return err
+}
@@ -0,0 +1,114 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type UserDeletedEvent struct {
+	UserId string `json:"user_id"`
+}
+
+// SubscribeUserDeleted listens on the users service deletion channel and calls
+// handle for every deleted account until ctx is cancelled. Payloads are either
+// a UserDeletedEvent JSON object or a bare user id.
+func SubscribeUserDeleted(ctx context.Context, channel string, handle func(ctx context.Context, userID string) error) error {
+	rdb := ConnectDB()
+	defer rdb.Close()
+
+	sub := rdb.Subscribe(ctx, channel)
+	defer sub.Close()
+
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+
+			var event UserDeletedEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				event.UserId = strings.Trim(strings.TrimSpace(msg.Payload), `"`)
+			}
+			if event.UserId == "" {
+				log.Println("Skipping user deleted event without user id: ", msg.Payload)
+				continue
+			}
+
+			if err := handle(ctx, event.UserId); err != nil {
+				log.Println("Error handling user deleted event: ", err)
+			}
+		}
+	}
+}
+
+const StoryPublishedChannel = "stories.published"
+
+type StoryPublishedEvent struct {
+	StoryId     string `json:"story_id"`
+	AuthorId    string `json:"author_id"`
+	Title       string `json:"title"`
+	PublishedAt string `json:"published_at"`
+}
+
+const MentionCreatedChannel = "mentions.created"
+
+type MentionEvent struct {
+	MentionId       string `json:"mention_id"`
+	MentionedUserId string `json:"mentioned_user_id"`
+	AuthorId        string `json:"author_id"`
+	SourceType      string `json:"source_type"`
+	SourceId        string `json:"source_id"`
+	TargetType      string `json:"target_type"`
+	TargetId        string `json:"target_id"`
+	CreatedAt       string `json:"created_at"`
+}
+
+const UserWarnedChannel = "moderation.warnings"
+
+type UserWarnedEvent struct {
+	UserId      string `json:"user_id"`
+	ModeratorId string `json:"moderator_id"`
+	EntityType  string `json:"entity_type"`
+	EntityId    string `json:"entity_id"`
+	Note        string `json:"note"`
+	CreatedAt   string `json:"created_at"`
+}
+
+const CollaboratorInvitedChannel = "stories.collaborators.invited"
+
+type CollaboratorInvitedEvent struct {
+	StoryId   string `json:"story_id"`
+	UserId    string `json:"user_id"`
+	InvitedBy string `json:"invited_by"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+}
+
+// Publisher emits JSON encoded events for other services on redis channels.
+type Publisher struct {
+	rdb *redis.Client
+}
+
+func NewPublisher(rdb *redis.Client) *Publisher {
+	return &Publisher{rdb: rdb}
+}
+
+func (p *Publisher) Publish(ctx context.Context, channel string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return p.rdb.Publish(ctx, channel, payload).Err()
+}
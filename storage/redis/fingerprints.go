@@ -0,0 +1,33 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Fingerprints remembers content fingerprints for the duplicate check of
+// the moderation pipeline.
+type Fingerprints struct {
+	rdb *redis.Client
+}
+
+func NewFingerprints(rdb *redis.Client) *Fingerprints {
+	return &Fingerprints{rdb: rdb}
+}
+
+// Seen reports whether key is recorded.
+func (f *Fingerprints) Seen(ctx context.Context, key string) (bool, error) {
+	n, err := f.rdb.Exists(ctx, "moderation:fingerprint:"+key).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Remember records key for ttl. A repeat does not extend the original
+// window.
+func (f *Fingerprints) Remember(ctx context.Context, key string, ttl time.Duration) error {
+	return f.rdb.SetNX(ctx, "moderation:fingerprint:"+key, 1, ttl).Err()
+}
@@ -1,6 +1,7 @@
 package redis
 
 import (
+	"content/config"
 	pb "content/genproto/content"
 	"content/storage/postgres"
 	"context"
@@ -12,10 +13,12 @@ import (
 )
 
 func ConnectDB() *redis.Client {
+	cfg := config.Load()
+
 	rdb := redis.NewClient(&redis.Options{
-		Addr:     "localhost:6379",
-		Password: "",
-		DB:       0,
+		Addr:     cfg.Redis.REDIS_ADDR,
+		Password: cfg.Redis.REDIS_PASSWORD,
+		DB:       cfg.Redis.REDIS_DB,
 	})
 
 	return rdb
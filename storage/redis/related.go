@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"content/pkg/related"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Related caches the related stories of each story, best first, for TTL.
+// Rankings are the same for every reader; per reader filtering happens when
+// the stories are loaded.
+type Related struct {
+	rdb *redis.Client
+	TTL time.Duration
+}
+
+func NewRelated(rdb *redis.Client, ttl time.Duration) *Related {
+	return &Related{rdb: rdb, TTL: ttl}
+}
+
+func relatedKey(storyID string) string {
+	return "related:story:" + storyID
+}
+
+// Get returns the cached ranking of a story and whether there was one. An
+// empty ranking is cached too, so stories without related stories are not
+// scored on every read.
+func (r *Related) Get(ctx context.Context, storyID string) ([]related.Scored, bool, error) {
+	payload, err := r.rdb.Get(ctx, relatedKey(storyID)).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	var ranked []related.Scored
+	if err := json.Unmarshal(payload, &ranked); err != nil {
+		return nil, false, err
+	}
+	return ranked, true, nil
+}
+
+func (r *Related) Set(ctx context.Context, storyID string, ranked []related.Scored) error {
+	payload, err := json.Marshal(ranked)
+	if err != nil {
+		return err
+	}
+	return r.rdb.Set(ctx, relatedKey(storyID), payload, r.TTL).Err()
+}
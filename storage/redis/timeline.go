@@ -0,0 +1,122 @@
+package redis
+
+import (
+	"content/pkg/cursor"
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Timelines keeps precomputed feeds for heavy readers in sorted sets of feed
+// keys scored by publication time. Only users who read their feed often get
+// a timeline; everybody else is served by the database query.
+type Timelines struct {
+	rdb  *redis.Client
+	Size int64
+	TTL  time.Duration
+}
+
+func NewTimelines(rdb *redis.Client, size int64, ttl time.Duration) *Timelines {
+	return &Timelines{rdb: rdb, Size: size, TTL: ttl}
+}
+
+func timelineKey(userID string) string {
+	return "feed:timeline:" + userID
+}
+
+// RecordRead counts a feed read and returns the user's reads in the current
+// day.
+func (t *Timelines) RecordRead(ctx context.Context, userID string) (int64, error) {
+	key := "feed:reads:" + userID + ":" + time.Now().UTC().Format("20060102")
+
+	pipe := t.rdb.TxPipeline()
+	reads := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, 24*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return reads.Val(), nil
+}
+
+func (t *Timelines) Exists(ctx context.Context, userID string) (bool, error) {
+	n, err := t.rdb.Exists(ctx, timelineKey(userID)).Result()
+	return n > 0, err
+}
+
+// Fill replaces a user's timeline with the given entries.
+func (t *Timelines) Fill(ctx context.Context, userID string, entries []cursor.Cursor) error {
+	key := timelineKey(userID)
+
+	pipe := t.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	if len(entries) > 0 {
+		members := make([]redis.Z, len(entries))
+		for i, e := range entries {
+			members[i] = redis.Z{Score: float64(e.Score), Member: e.Key}
+		}
+		pipe.ZAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, t.TTL)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Range returns up to count timeline entries that come after the given
+// position, newest first, and keeps the timeline alive for another TTL.
+func (t *Timelines) Range(ctx context.Context, userID string, after *cursor.Cursor, count int64) ([]cursor.Cursor, error) {
+	key := timelineKey(userID)
+
+	max := "+inf"
+	if after != nil {
+		max = strconv.FormatInt(after.Score, 10)
+	}
+
+	// Entries sharing the cursor's score may sort before it, fetch a few
+	// extra to make up for them.
+	members, err := t.rdb.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Max:   max,
+		Min:   "-inf",
+		Count: count + 16,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	t.rdb.Expire(ctx, key, t.TTL)
+
+	var entries []cursor.Cursor
+	for _, m := range members {
+		entry := cursor.Cursor{Score: int64(m.Score), Key: m.Member.(string)}
+		if after != nil && !after.After(entry.Score, entry.Key) {
+			continue
+		}
+		entries = append(entries, entry)
+		if int64(len(entries)) == count {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+// pushScript adds an entry to a timeline that already exists and trims it to
+// its maximum size. Users without a timeline are skipped.
+var pushScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+    return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('ZREMRANGEBYRANK', KEYS[1], 0, -tonumber(ARGV[3]) - 1)
+return 1
+`)
+
+// Push fans a new entry out to the timelines of the given users.
+func (t *Timelines) Push(ctx context.Context, userIDs []string, entry cursor.Cursor) error {
+	pipe := t.rdb.Pipeline()
+	for _, userID := range userIDs {
+		pushScript.Eval(ctx, pipe, []string{timelineKey(userID)}, entry.Score, entry.Key, t.Size)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
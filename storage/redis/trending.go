@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"content/pkg/trending"
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// MaxTrendingStories is how many stories each trending ranking keeps.
+const MaxTrendingStories = 1000
+
+// Trending keeps one sorted set of story ids per trending window, scored by
+// hotness.
+type Trending struct {
+	rdb *redis.Client
+}
+
+func NewTrending(rdb *redis.Client) *Trending {
+	return &Trending{rdb: rdb}
+}
+
+func trendingKey(w trending.Window) string {
+	return "trending:stories:" + string(w)
+}
+
+// Set stores a story's score in the windows it belongs to and removes it
+// from the others. An empty map removes the story everywhere.
+func (t *Trending) Set(ctx context.Context, storyID string, scores map[trending.Window]float64) error {
+	pipe := t.rdb.TxPipeline()
+	for _, w := range trending.Windows {
+		key := trendingKey(w)
+		score, ok := scores[w]
+		if !ok {
+			pipe.ZRem(ctx, key, storyID)
+			continue
+		}
+		pipe.ZAdd(ctx, key, redis.Z{Score: score, Member: storyID})
+		pipe.ZRemRangeByRank(ctx, key, 0, -MaxTrendingStories-1)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Replace swaps a window's ranking for a freshly computed one.
+func (t *Trending) Replace(ctx context.Context, w trending.Window, scores map[string]float64) error {
+	key := trendingKey(w)
+	if len(scores) == 0 {
+		return t.rdb.Del(ctx, key).Err()
+	}
+
+	members := make([]redis.Z, 0, len(scores))
+	for storyID, score := range scores {
+		members = append(members, redis.Z{Score: score, Member: storyID})
+	}
+
+	tmp := key + ":next"
+	pipe := t.rdb.TxPipeline()
+	pipe.Del(ctx, tmp)
+	pipe.ZAdd(ctx, tmp, members...)
+	pipe.ZRemRangeByRank(ctx, tmp, 0, -MaxTrendingStories-1)
+	pipe.Rename(ctx, tmp, key)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Ranking returns the story ids of a window, hottest first.
+func (t *Trending) Ranking(ctx context.Context, w trending.Window) ([]string, error) {
+	return t.rdb.ZRevRange(ctx, trendingKey(w), 0, MaxTrendingStories-1).Result()
+}
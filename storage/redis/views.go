@@ -0,0 +1,125 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	viewsKeyPrefix   = "views:story:"
+	viewsPendingKey  = "views:windows:pending"
+	viewsFlushingKey = "views:windows:flushing"
+)
+
+// Views deduplicates story views per viewer and window with one HyperLogLog
+// per story and window. The windows that saw views are remembered until they
+// are flushed, and each flush adds how much a window's count grew since the
+// previous one.
+type Views struct {
+	rdb    *redis.Client
+	Window time.Duration
+}
+
+func NewViews(rdb *redis.Client, window time.Duration) *Views {
+	return &Views{rdb: rdb, Window: window}
+}
+
+// PendingViews are the views counted since the last flush.
+type PendingViews struct {
+	// Counts maps story ids to their new views.
+	Counts map[string]int64
+
+	// windows holds the count of every flushed window key.
+	windows map[string]int64
+}
+
+// Record adds a viewer to the story's current window and reports whether
+// the view counted, that is whether the viewer was not seen in the window
+// yet. Like every HyperLogLog answer this is an estimate; the stored counts
+// come from the window's cardinality instead.
+func (v *Views) Record(ctx context.Context, storyID, viewer string) (bool, error) {
+	bucket := time.Now().UnixNano() / int64(v.Window)
+	key := viewsKeyPrefix + storyID + ":" + strconv.FormatInt(bucket, 10)
+
+	// Windows outlive their end by one more window so that views recorded
+	// late in a window are still flushed.
+	pipe := v.rdb.TxPipeline()
+	added := pipe.PFAdd(ctx, key, viewer)
+	pipe.Expire(ctx, key, 2*v.Window)
+	pipe.SAdd(ctx, viewsPendingKey, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+
+	return added.Val() == 1, nil
+}
+
+// TakePending returns the views counted since the last flush. The windows
+// stay set aside until Flushed is called, so a failed flush is retried on the
+// next call instead of losing them.
+func (v *Views) TakePending(ctx context.Context) (*PendingViews, error) {
+	n, err := v.rdb.Exists(ctx, viewsFlushingKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		n, err := v.rdb.Exists(ctx, viewsPendingKey).Result()
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return &PendingViews{}, nil
+		}
+		if err := v.rdb.RenameNX(ctx, viewsPendingKey, viewsFlushingKey).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	keys, err := v.rdb.SMembers(ctx, viewsFlushingKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	pipe := v.rdb.Pipeline()
+	counts := make([]*redis.IntCmd, len(keys))
+	flushed := make([]*redis.StringCmd, len(keys))
+	for i, key := range keys {
+		counts[i] = pipe.PFCount(ctx, key)
+		flushed[i] = pipe.Get(ctx, key+":flushed")
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	pending := &PendingViews{Counts: make(map[string]int64), windows: make(map[string]int64, len(keys))}
+	for i, key := range keys {
+		count := counts[i].Val()
+		previous, err := flushed[i].Int64()
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+
+		pending.windows[key] = count
+		if count > previous {
+			storyID := strings.TrimPrefix(key[:strings.LastIndexByte(key, ':')], viewsKeyPrefix)
+			pending.Counts[storyID] += count - previous
+		}
+	}
+	return pending, nil
+}
+
+// Flushed remembers the window counts returned by TakePending once they are
+// stored, so the next flush only adds what came after them.
+func (v *Views) Flushed(ctx context.Context, pending *PendingViews) error {
+	pipe := v.rdb.TxPipeline()
+	for key, count := range pending.windows {
+		pipe.Set(ctx, key+":flushed", count, 2*v.Window)
+	}
+	pipe.Del(ctx, viewsFlushingKey)
+	_, err := pipe.Exec(ctx)
+	return err
+}